@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -33,7 +32,7 @@ func NewAPI(m *gobot.Master) *API {
 		router: pat.New(),
 		Port:   "3000",
 		start: func(a *API) {
-			log.Println("Initializing API on " + a.Host + ":" + a.Port + "...")
+			gobot.Log().Info("Initializing API...", "address", a.Host+":"+a.Port)
 			http.Handle("/", a)
 
 			go func() {
@@ -42,7 +41,7 @@ func NewAPI(m *gobot.Master) *API {
 						panic(err)
 					}
 				} else {
-					log.Println("WARNING: API using insecure connection. " +
+					gobot.Log().Warn("API using insecure connection. " +
 						"We recommend using an SSL certificate with Gobot.")
 					if err := http.ListenAndServe(a.Host+":"+a.Port, nil); err != nil {
 						panic(err)
@@ -141,6 +140,8 @@ func (a *API) AddC3PIORoutes() {
 	a.Post(robotDeviceCommandRoute, a.executeRobotDeviceCommand)
 	a.Get("/api/robots/:robot/connections", a.robotConnections)
 	a.Get("/api/robots/:robot/connections/:connection", a.robotConnection)
+	a.Get("/api/health", a.health)
+	a.Get("/api/robots/:robot/health", a.robotHealth)
 	a.Get("/api/", a.mcp)
 }
 
@@ -280,7 +281,7 @@ func (a *API) robotDeviceEvent(res http.ResponseWriter, req *http.Request) {
 				fmt.Fprintf(res, "data: %v\n\n", data)
 				f.Flush()
 			case <-req.Context().Done():
-				log.Println("Closing connection")
+				gobot.Log().Debug("Closing event stream connection")
 				return
 			}
 		}
@@ -326,6 +327,40 @@ func (a *API) robotConnection(res http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// health returns health route handler.
+// Writes JSON with the connection states of all robots, the status is 503 if
+// any connection has failed
+func (a *API) health(res http.ResponseWriter, req *http.Request) {
+	robots := []map[string]interface{}{}
+	healthy := true
+	a.master.Robots().Each(func(r *gobot.Robot) {
+		connections, ok := jsonConnectionHealthFor(r)
+		healthy = healthy && ok
+		robots = append(robots, map[string]interface{}{"name": r.Name, "connections": connections})
+	})
+	status := http.StatusOK
+	if !healthy {
+		status = http.StatusServiceUnavailable
+	}
+	a.writeJSONWithStatus(map[string]interface{}{"robots": robots}, status, res)
+}
+
+// robotHealth returns robot health route handler.
+// Writes JSON with the connection states of the robot, the status is 503 if
+// any connection has failed
+func (a *API) robotHealth(res http.ResponseWriter, req *http.Request) {
+	if robot := a.master.Robot(req.URL.Query().Get(":robot")); robot != nil {
+		connections, ok := jsonConnectionHealthFor(robot)
+		status := http.StatusOK
+		if !ok {
+			status = http.StatusServiceUnavailable
+		}
+		a.writeJSONWithStatus(map[string]interface{}{"connections": connections}, status, res)
+	} else {
+		a.writeJSON(map[string]interface{}{"error": "No Robot found with the name " + req.URL.Query().Get(":robot")}, res)
+	}
+}
+
 // executeMcpCommand calls a global command associated to requested route
 func (a *API) executeMcpCommand(res http.ResponseWriter, req *http.Request) {
 	a.executeCommand(a.master.Command(req.URL.Query().Get(":command")),
@@ -382,10 +417,31 @@ func (a *API) executeCommand(f func(map[string]interface{}) interface{},
 	}
 }
 
+// jsonConnectionHealthFor returns the health of all connections of the robot
+// and false if any of them has failed
+func jsonConnectionHealthFor(robot *gobot.Robot) ([]*gobot.JSONConnectionHealth, bool) {
+	healthy := true
+	connections := []*gobot.JSONConnectionHealth{}
+	robot.Connections().Each(func(c gobot.Connection) {
+		health := gobot.NewJSONConnectionHealth(robot, c)
+		if health.State == gobot.ConnectionFailed {
+			healthy = false
+		}
+		connections = append(connections, health)
+	})
+	return connections, healthy
+}
+
 // writeJSON writes `j` as JSON in response
 func (a *API) writeJSON(j interface{}, res http.ResponseWriter) {
+	a.writeJSONWithStatus(j, http.StatusOK, res)
+}
+
+// writeJSONWithStatus writes `j` as JSON in response with the given status code
+func (a *API) writeJSONWithStatus(j interface{}, status int, res http.ResponseWriter) {
 	data, _ := json.Marshal(j)
 	res.Header().Set("Content-Type", "application/json; charset=utf-8")
+	res.WriteHeader(status)
 	if _, err := res.Write(data); err != nil {
 		panic(err)
 	}
@@ -394,7 +450,7 @@ func (a *API) writeJSON(j interface{}, res http.ResponseWriter) {
 // Debug add handler to api that prints each request
 func (a *API) Debug() {
 	a.AddHandler(func(res http.ResponseWriter, req *http.Request) {
-		log.Println(req)
+		gobot.Log().Info("API request", "method", req.Method, "url", req.URL, "remote", req.RemoteAddr)
 	})
 }
 
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -381,6 +382,54 @@ func TestRobotConnection(t *testing.T) {
 	gobottest.Assert(t, body["error"], "No Connection found with the name UnknownConnection1")
 }
 
+func TestHealth(t *testing.T) {
+	a := initTestAPI()
+	request, _ := http.NewRequest("GET", "/api/health", nil)
+	response := httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	gobottest.Assert(t, response.Code, 200)
+
+	var body map[string]interface{}
+	_ = json.NewDecoder(response.Body).Decode(&body)
+	robots := body["robots"].([]interface{})
+	gobottest.Assert(t, len(robots), 3)
+	robot := robots[0].(map[string]interface{})
+	gobottest.Assert(t, robot["name"], "Robot1")
+	connection := robot["connections"].([]interface{})[0].(map[string]interface{})
+	gobottest.Assert(t, connection["name"], "Connection1")
+	gobottest.Assert(t, connection["state"], "disconnected")
+}
+
+func TestRobotHealth(t *testing.T) {
+	a := initTestAPI()
+	failed := &testStaterAdaptor{
+		testAdaptor: testAdaptor{name: "Failed"},
+		state:       gobot.ConnectionFailed,
+		lastErr:     errors.New("no response"),
+	}
+	a.master.Robot("Robot1").AddConnection(failed)
+
+	request, _ := http.NewRequest("GET", "/api/robots/Robot1/health", nil)
+	response := httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	gobottest.Assert(t, response.Code, 503)
+
+	var body map[string]interface{}
+	_ = json.NewDecoder(response.Body).Decode(&body)
+	connections := body["connections"].([]interface{})
+	gobottest.Assert(t, len(connections), 4)
+	connection := connections[3].(map[string]interface{})
+	gobottest.Assert(t, connection["state"], "failed")
+	gobottest.Assert(t, connection["last_error"], "no response")
+
+	// unknown robot
+	request, _ = http.NewRequest("GET", "/api/robots/UnknownRobot1/health", nil)
+	response = httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	_ = json.NewDecoder(response.Body).Decode(&body)
+	gobottest.Assert(t, body["error"], "No Robot found with the name UnknownRobot1")
+}
+
 func TestRobotDeviceEvent(t *testing.T) {
 	a := initTestAPI()
 	server := httptest.NewServer(a)
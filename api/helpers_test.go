@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"time"
 
 	"gobot.io/x/gobot/v2"
 )
@@ -80,6 +81,16 @@ func newTestAdaptor(name string, port string) *testAdaptor {
 	}
 }
 
+type testStaterAdaptor struct {
+	testAdaptor
+	state   string
+	lastErr error
+}
+
+func (t *testStaterAdaptor) ConnectionState() (string, time.Time, error) {
+	return t.state, time.Now(), t.lastErr
+}
+
 func newTestRobot(name string) *gobot.Robot {
 	adaptor1 := newTestAdaptor("Connection1", "/dev/null")
 	adaptor2 := newTestAdaptor("Connection2", "/dev/null")
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+)
+
+// TokenAuth returns bearer token auth handler.
+// Requests must carry the header "Authorization: Bearer <token>", the scheme
+// is matched case-insensitively.
+func TokenAuth(token string) http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		scheme, given, _ := strings.Cut(req.Header.Get("Authorization"), " ")
+		if token == "" || !strings.EqualFold(scheme, "Bearer") || !secureCompare(given, token) {
+			res.Header().Set("WWW-Authenticate",
+				"Bearer realm=\"Authorization Required\"",
+			)
+			http.Error(res, "Not Authorized", http.StatusUnauthorized)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gobot.io/x/gobot/v2/gobottest"
+)
+
+func TestTokenAuth(t *testing.T) {
+	a := initTestAPI()
+
+	a.AddHandler(TokenAuth("s3cr3t"))
+
+	request, _ := http.NewRequest("GET", "/api/", nil)
+	request.Header.Set("Authorization", "Bearer s3cr3t")
+	response := httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	gobottest.Assert(t, response.Code, 200)
+
+	request, _ = http.NewRequest("GET", "/api/", nil)
+	request.Header.Set("Authorization", "bearer s3cr3t")
+	response = httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	gobottest.Assert(t, response.Code, 200)
+
+	request, _ = http.NewRequest("GET", "/api/", nil)
+	request.Header.Set("Authorization", "Basic s3cr3t")
+	response = httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	gobottest.Assert(t, response.Code, 401)
+
+	request, _ = http.NewRequest("GET", "/api/", nil)
+	request.Header.Set("Authorization", "Bearer wrongToken")
+	response = httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	gobottest.Assert(t, response.Code, 401)
+	gobottest.Assert(t, response.Header().Get("WWW-Authenticate"), "Bearer realm=\"Authorization Required\"")
+
+	request, _ = http.NewRequest("GET", "/api/", nil)
+	response = httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	gobottest.Assert(t, response.Code, 401)
+}
+
+func TestTokenAuthEmptyToken(t *testing.T) {
+	a := initTestAPI()
+
+	a.AddHandler(TokenAuth(""))
+
+	request, _ := http.NewRequest("GET", "/api/", nil)
+	request.Header.Set("Authorization", "Bearer ")
+	response := httptest.NewRecorder()
+	a.ServeHTTP(response, request)
+	gobottest.Assert(t, response.Code, 401)
+}
@@ -1,8 +1,8 @@
 package gobot
 
 import (
-	"log"
 	"reflect"
+	"time"
 
 	multierror "github.com/hashicorp/go-multierror"
 )
@@ -21,6 +21,59 @@ func NewJSONConnection(connection Connection) *JSONConnection {
 	}
 }
 
+// States of a Connection, as reported by a ConnectionStater
+const (
+	ConnectionConnected    = "connected"
+	ConnectionReconnecting = "reconnecting"
+	ConnectionFailed       = "failed"
+	ConnectionDisconnected = "disconnected"
+)
+
+// ConnectionStater is the interface for adaptors which track the state of
+// their connection, e.g. to report a reconnect in progress.
+type ConnectionStater interface {
+	// ConnectionState returns the current state, the time since the connection
+	// is in this state and the last error of the connection, if any
+	ConnectionState() (state string, since time.Time, lastErr error)
+}
+
+// JSONConnectionHealth is a JSON representation of the health of a Connection.
+type JSONConnectionHealth struct {
+	Name      string  `json:"name"`
+	Adaptor   string  `json:"adaptor"`
+	State     string  `json:"state"`
+	LastError string  `json:"last_error,omitempty"`
+	Uptime    float64 `json:"uptime"`
+}
+
+// NewJSONConnectionHealth returns a JSONConnectionHealth given a Connection of
+// the Robot. Adaptors which do not implement ConnectionStater are reported as
+// connected while the Robot is running.
+func NewJSONConnectionHealth(robot *Robot, connection Connection) *JSONConnectionHealth {
+	health := &JSONConnectionHealth{
+		Name:    connection.Name(),
+		Adaptor: reflect.TypeOf(connection).String(),
+		State:   ConnectionDisconnected,
+	}
+
+	var since time.Time
+	if stater, ok := connection.(ConnectionStater); ok {
+		var lastErr error
+		health.State, since, lastErr = stater.ConnectionState()
+		if lastErr != nil {
+			health.LastError = lastErr.Error()
+		}
+	} else if robot.Running() {
+		health.State = ConnectionConnected
+		since = robot.StartedAt()
+	}
+
+	if health.State == ConnectionConnected && !since.IsZero() {
+		health.Uptime = time.Since(since).Seconds()
+	}
+	return health
+}
+
 // A Connection is an instance of an Adaptor
 type Connection Adaptor
 
@@ -41,16 +94,9 @@ func (c *Connections) Each(f func(Connection)) {
 
 // Start calls Connect on each Connection in c
 func (c *Connections) Start() (err error) {
-	log.Println("Starting connections...")
+	Log().Info("Starting connections...")
 	for _, connection := range *c {
-		info := "Starting connection " + connection.Name()
-
-		if porter, ok := connection.(Porter); ok {
-			info = info + " on port " + porter.Port()
-		}
-
-		log.Println(info + "...")
-
+		logStartConnection(connection)
 		if cerr := connection.Connect(); cerr != nil {
 			err = multierror.Append(err, cerr)
 		}
@@ -58,6 +104,15 @@ func (c *Connections) Start() (err error) {
 	return err
 }
 
+// logStartConnection logs the start of the connection, with its port if any
+func logStartConnection(connection Connection) {
+	args := []interface{}{"name", connection.Name()}
+	if porter, ok := connection.(Porter); ok {
+		args = append(args, "port", porter.Port())
+	}
+	Log().Info("Starting connection...", args...)
+}
+
 // Finalize calls Finalize on each Connection in c
 func (c *Connections) Finalize() (err error) {
 	for _, connection := range *c {
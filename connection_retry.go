@@ -0,0 +1,71 @@
+package gobot
+
+import (
+	"fmt"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// ConnectionRetryPolicy describes how often a Robot retries to connect a
+// connection which fails on Start, e.g. because the board is still rebooting.
+type ConnectionRetryPolicy struct {
+	// Retries is the number of additional calls of Connect after a failure.
+	Retries int
+	// Backoff is the delay before the first retry, it is doubled for each
+	// further retry.
+	Backoff time.Duration
+	// MaxBackoff limits the delay between retries. Zero means no limit.
+	MaxBackoff time.Duration
+}
+
+// retrySleep waits between two retries, it is replaced by tests
+var retrySleep = time.Sleep
+
+// SetConnectionRetryPolicy sets the policy used by Start for the connection c.
+// Without any policy, a failing Connect aborts the start of the Robot.
+func (r *Robot) SetConnectionRetryPolicy(c Connection, policy ConnectionRetryPolicy) {
+	if r.retryPolicies == nil {
+		r.retryPolicies = make(map[Connection]ConnectionRetryPolicy)
+	}
+	r.retryPolicies[c] = policy
+}
+
+// startConnections connects all connections of the Robot, retrying failed
+// connections according to their retry policy
+func (r *Robot) startConnections() (err error) {
+	if len(r.retryPolicies) == 0 {
+		return r.Connections().Start()
+	}
+
+	Log().Info("Starting connections...")
+	for _, connection := range *r.connections {
+		logStartConnection(connection)
+		if cerr := connectWithRetry(connection, r.retryPolicies[connection]); cerr != nil {
+			err = multierror.Append(err, cerr)
+		}
+	}
+	return err
+}
+
+// connectWithRetry calls Connect until it succeeds or the retries are exhausted
+func connectWithRetry(connection Connection, policy ConnectionRetryPolicy) error {
+	backoff := policy.Backoff
+	err := connection.Connect()
+	for retry := 1; err != nil && retry <= policy.Retries; retry++ {
+		Log().Warn("Connecting failed, retrying...", "name", connection.Name(),
+			"retry", retry, "backoff", backoff, "error", err)
+		retrySleep(backoff)
+
+		err = connection.Connect()
+
+		backoff *= 2
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+	if err != nil && policy.Retries > 0 {
+		return fmt.Errorf("connection %s failed after %d retries: %w", connection.Name(), policy.Retries, err)
+	}
+	return err
+}
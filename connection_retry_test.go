@@ -0,0 +1,75 @@
+package gobot
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gobot.io/x/gobot/v2/gobottest"
+)
+
+type flakyAdaptor struct {
+	testAdaptor
+	failures int
+	calls    int
+}
+
+func (a *flakyAdaptor) Connect() error {
+	a.calls++
+	if a.calls <= a.failures {
+		return errors.New("board not ready")
+	}
+	return nil
+}
+
+func TestRobotConnectionRetry(t *testing.T) {
+	adaptor := &flakyAdaptor{testAdaptor: testAdaptor{name: "Sparki"}, failures: 2}
+	r := NewRobot("Robot99", []Connection{adaptor})
+	r.AutoRun = false
+	r.SetConnectionRetryPolicy(adaptor, ConnectionRetryPolicy{Retries: 3, Backoff: time.Millisecond})
+
+	gobottest.Assert(t, r.Start(), nil)
+	gobottest.Assert(t, adaptor.calls, 3)
+	gobottest.Assert(t, r.Stop(), nil)
+}
+
+func TestRobotConnectionRetryExhausted(t *testing.T) {
+	adaptor := &flakyAdaptor{testAdaptor: testAdaptor{name: "Sparki"}, failures: 5}
+	r := NewRobot("Robot99", []Connection{adaptor})
+	r.AutoRun = false
+	r.SetConnectionRetryPolicy(adaptor, ConnectionRetryPolicy{Retries: 2, Backoff: time.Millisecond})
+
+	err := r.Start()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "connection Sparki failed after 2 retries: board not ready"), true)
+	gobottest.Assert(t, adaptor.calls, 3)
+	gobottest.Assert(t, r.Running(), false)
+}
+
+func TestRobotConnectionWithoutRetryPolicy(t *testing.T) {
+	adaptor := &flakyAdaptor{testAdaptor: testAdaptor{name: "Sparki"}, failures: 1}
+	other := &flakyAdaptor{testAdaptor: testAdaptor{name: "Other"}, failures: 1}
+	r := NewRobot("Robot99", []Connection{adaptor, other})
+	r.AutoRun = false
+	r.SetConnectionRetryPolicy(adaptor, ConnectionRetryPolicy{Retries: 1})
+
+	err := r.Start()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "Sparki"), false)
+	gobottest.Assert(t, adaptor.calls, 2)
+	gobottest.Assert(t, other.calls, 1)
+}
+
+func TestConnectWithRetryMaxBackoff(t *testing.T) {
+	adaptor := &flakyAdaptor{testAdaptor: testAdaptor{name: "Sparki"}, failures: 3}
+	policy := ConnectionRetryPolicy{Retries: 3, Backoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	var delays []time.Duration
+	retrySleep = func(d time.Duration) { delays = append(delays, d) }
+	defer func() { retrySleep = time.Sleep }()
+
+	gobottest.Assert(t, connectWithRetry(adaptor, policy), nil)
+	gobottest.Assert(t, adaptor.calls, 4)
+	// without the limit the last delay would be 4ms
+	gobottest.Assert(t, delays, []time.Duration{time.Millisecond, 2 * time.Millisecond, 2 * time.Millisecond})
+}
@@ -1,7 +1,6 @@
 package gobot
 
 import (
-	"log"
 	"reflect"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -54,15 +53,9 @@ func (d *Devices) Each(f func(Device)) {
 
 // Start calls Start on each Device in d
 func (d *Devices) Start() (err error) {
-	log.Println("Starting devices...")
+	Log().Info("Starting devices...")
 	for _, device := range *d {
-		info := "Starting device " + device.Name()
-
-		if pinner, ok := device.(Pinner); ok {
-			info = info + " on pin " + pinner.Pin()
-		}
-
-		log.Println(info + "...")
+		logStartDevice(device)
 		if derr := device.Start(); derr != nil {
 			err = multierror.Append(err, derr)
 		}
@@ -70,6 +63,15 @@ func (d *Devices) Start() (err error) {
 	return err
 }
 
+// logStartDevice logs the start of the device, with its pin if any
+func logStartDevice(device Device) {
+	args := []interface{}{"name", device.Name()}
+	if pinner, ok := device.(Pinner); ok {
+		args = append(args, "pin", pinner.Pin())
+	}
+	Log().Info("Starting device...", args...)
+}
+
 // Halt calls Halt on each Device in d
 func (d *Devices) Halt() (err error) {
 	for _, device := range *d {
@@ -0,0 +1,149 @@
+package gobot
+
+import (
+	"fmt"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// DeviceStartPolicy describes when a device of a Robot is started.
+type DeviceStartPolicy struct {
+	// After lists devices of the Robot which must be started before the device.
+	After []Device
+	// WaitConnected delays the start of the device until its connection reports
+	// to be connected. This only has an effect for adaptors implementing
+	// ConnectionStater, all other connections are connected after Connect.
+	WaitConnected bool
+	// Retries is the number of additional checks of the connection state
+	// before the start of the device fails.
+	Retries int
+	// RetryInterval is the delay between checks of the connection state.
+	RetryInterval time.Duration
+}
+
+// SetDeviceStartPolicy sets the policy used by Start for the device d. Without
+// any policy, devices are started in the order they were added to the Robot.
+func (r *Robot) SetDeviceStartPolicy(d Device, policy DeviceStartPolicy) {
+	if r.startPolicies == nil {
+		r.startPolicies = make(map[Device]DeviceStartPolicy)
+	}
+	r.startPolicies[d] = policy
+}
+
+// startDevices starts all devices of the Robot respecting the start policies.
+// A device is not started if one of the devices it depends on fails to start.
+func (r *Robot) startDevices() (err error) {
+	if len(r.startPolicies) == 0 {
+		return r.Devices().Start()
+	}
+
+	ordered, err := r.orderedDevices()
+	if err != nil {
+		return err
+	}
+
+	Log().Info("Starting devices...")
+	failed := make(map[Device]bool)
+	for _, device := range ordered {
+		policy := r.startPolicies[device]
+		if derr := r.startDevice(device, policy, failed); derr != nil {
+			failed[device] = true
+			err = multierror.Append(err, derr)
+		}
+	}
+	return err
+}
+
+// startDevice starts a single device after checking its dependencies and
+// connection according to the policy
+func (r *Robot) startDevice(device Device, policy DeviceStartPolicy, failed map[Device]bool) error {
+	for _, dep := range policy.After {
+		if failed[dep] {
+			return fmt.Errorf("device %s not started, because device %s failed to start", device.Name(), dep.Name())
+		}
+	}
+
+	if policy.WaitConnected {
+		if err := waitConnected(device, policy); err != nil {
+			return err
+		}
+	}
+
+	logStartDevice(device)
+	return device.Start()
+}
+
+// waitConnected checks the state of the connection of the device until it is
+// connected or the retries are exhausted
+func waitConnected(device Device, policy DeviceStartPolicy) error {
+	stater, ok := device.Connection().(ConnectionStater)
+	if !ok {
+		return nil
+	}
+
+	var state string
+	var lastErr error
+	for i := 0; i <= policy.Retries; i++ {
+		if i > 0 {
+			time.Sleep(policy.RetryInterval)
+		}
+		if state, _, lastErr = stater.ConnectionState(); state == ConnectionConnected {
+			return nil
+		}
+	}
+
+	err := fmt.Errorf("device %s not started, because connection %s is %s",
+		device.Name(), device.Connection().Name(), state)
+	if lastErr != nil {
+		err = fmt.Errorf("%v: %w", err, lastErr)
+	}
+	return err
+}
+
+// orderedDevices returns the devices of the Robot sorted so that each device
+// comes after the devices it depends on. Apart from that the order in which
+// the devices were added is kept.
+func (r *Robot) orderedDevices() ([]Device, error) {
+	known := make(map[Device]bool)
+	for _, device := range *r.devices {
+		known[device] = true
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	marks := make(map[Device]int)
+	ordered := make([]Device, 0, r.devices.Len())
+
+	var visit func(device Device) error
+	visit = func(device Device) error {
+		switch marks[device] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("device %s has a cyclic start dependency", device.Name())
+		}
+		marks[device] = visiting
+		for _, dep := range r.startPolicies[device].After {
+			if !known[dep] {
+				return fmt.Errorf("device %s depends on device %s, which is not part of robot %s",
+					device.Name(), dep.Name(), r.Name)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		marks[device] = visited
+		ordered = append(ordered, device)
+		return nil
+	}
+
+	for _, device := range *r.devices {
+		if err := visit(device); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,193 @@
+package gobot
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gobot.io/x/gobot/v2/gobottest"
+)
+
+type startRecordingDriver struct {
+	testDriver
+	started *[]string
+	err     error
+}
+
+func (d *startRecordingDriver) Start() error {
+	*d.started = append(*d.started, d.name)
+	return d.err
+}
+
+type testStaterAdaptor struct {
+	testAdaptor
+	states []string
+}
+
+func (a *testStaterAdaptor) ConnectionState() (string, time.Time, error) {
+	state := a.states[0]
+	if len(a.states) > 1 {
+		a.states = a.states[1:]
+	}
+	return state, time.Now(), errors.New("no handshake")
+}
+
+func newStartRecordingRobot(started *[]string, names ...string) (*Robot, []*startRecordingDriver) {
+	adaptor := newTestAdaptor("Connection1", "/dev/null")
+	r := NewRobot("Robot99", []Connection{adaptor})
+	r.AutoRun = false
+	var drivers []*startRecordingDriver
+	for _, name := range names {
+		d := &startRecordingDriver{testDriver: *newTestDriver(adaptor, name, "0"), started: started}
+		r.AddDevice(d)
+		drivers = append(drivers, d)
+	}
+	return r, drivers
+}
+
+func TestRobotDeviceStartOrder(t *testing.T) {
+	var started []string
+	r, d := newStartRecordingRobot(&started, "led", "lcd", "motor")
+	r.SetDeviceStartPolicy(d[0], DeviceStartPolicy{After: []Device{d[2]}})
+	r.SetDeviceStartPolicy(d[2], DeviceStartPolicy{After: []Device{d[1]}})
+
+	gobottest.Assert(t, r.Start(), nil)
+	gobottest.Assert(t, started, []string{"lcd", "motor", "led"})
+	gobottest.Assert(t, r.Stop(), nil)
+}
+
+func TestRobotDeviceStartDependencyFailed(t *testing.T) {
+	var started []string
+	r, d := newStartRecordingRobot(&started, "led", "lcd")
+	d[1].err = errors.New("lcd error")
+	r.SetDeviceStartPolicy(d[0], DeviceStartPolicy{After: []Device{d[1]}})
+
+	err := r.Start()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "lcd error"), true)
+	gobottest.Assert(t, strings.Contains(err.Error(), "device led not started, because device lcd failed to start"), true)
+	gobottest.Assert(t, started, []string{"lcd"})
+}
+
+func TestRobotDeviceStartCycle(t *testing.T) {
+	var started []string
+	r, d := newStartRecordingRobot(&started, "led", "lcd")
+	r.SetDeviceStartPolicy(d[0], DeviceStartPolicy{After: []Device{d[1]}})
+	r.SetDeviceStartPolicy(d[1], DeviceStartPolicy{After: []Device{d[0]}})
+
+	err := r.Start()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "cyclic start dependency"), true)
+	gobottest.Assert(t, len(started), 0)
+}
+
+func TestRobotDeviceStartUnknownDependency(t *testing.T) {
+	var started []string
+	r, d := newStartRecordingRobot(&started, "led")
+	other := newTestDriver(newTestAdaptor("Connection2", "/dev/null"), "other", "1")
+	r.SetDeviceStartPolicy(d[0], DeviceStartPolicy{After: []Device{other}})
+
+	err := r.Start()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "which is not part of robot Robot99"), true)
+}
+
+func TestRobotDeviceStartWaitConnected(t *testing.T) {
+	var started []string
+	adaptor := &testStaterAdaptor{
+		testAdaptor: testAdaptor{name: "Sparki"},
+		states:      []string{ConnectionReconnecting, ConnectionReconnecting, ConnectionConnected},
+	}
+	led := &startRecordingDriver{testDriver: *newTestDriver(newTestAdaptor("", ""), "led", "0"), started: &started}
+	led.connection = adaptor
+	r := NewRobot("Robot99", []Connection{adaptor}, []Device{led})
+	r.AutoRun = false
+	r.SetDeviceStartPolicy(led, DeviceStartPolicy{WaitConnected: true, Retries: 2, RetryInterval: time.Millisecond})
+
+	gobottest.Assert(t, r.Start(), nil)
+	gobottest.Assert(t, started, []string{"led"})
+	gobottest.Assert(t, r.Stop(), nil)
+}
+
+func TestRobotDeviceStartWaitConnectedTimeout(t *testing.T) {
+	var started []string
+	adaptor := &testStaterAdaptor{
+		testAdaptor: testAdaptor{name: "Sparki"},
+		states:      []string{ConnectionReconnecting},
+	}
+	led := &startRecordingDriver{testDriver: *newTestDriver(newTestAdaptor("", ""), "led", "0"), started: &started}
+	led.connection = adaptor
+	r := NewRobot("Robot99", []Connection{adaptor}, []Device{led})
+	r.AutoRun = false
+	r.SetDeviceStartPolicy(led, DeviceStartPolicy{WaitConnected: true, Retries: 1, RetryInterval: time.Millisecond})
+
+	err := r.Start()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "connection Sparki is reconnecting: no handshake"), true)
+	gobottest.Assert(t, len(started), 0)
+}
+
+type haltRecordingDriver struct {
+	startRecordingDriver
+	halted *[]string
+	block  chan bool
+}
+
+func (d *haltRecordingDriver) Halt() error {
+	if d.block != nil {
+		<-d.block
+	}
+	*d.halted = append(*d.halted, d.name)
+	return nil
+}
+
+func TestRobotDeviceHaltOrder(t *testing.T) {
+	var started, halted []string
+	adaptor := newTestAdaptor("Connection1", "/dev/null")
+	r := NewRobot("Robot99", []Connection{adaptor})
+	r.AutoRun = false
+	var d []*haltRecordingDriver
+	for _, name := range []string{"led", "lcd", "motor"} {
+		driver := &haltRecordingDriver{halted: &halted}
+		driver.startRecordingDriver = startRecordingDriver{testDriver: *newTestDriver(adaptor, name, "0"), started: &started}
+		r.AddDevice(driver)
+		d = append(d, driver)
+	}
+	r.SetDeviceStartPolicy(d[0], DeviceStartPolicy{After: []Device{d[2]}})
+
+	gobottest.Assert(t, r.Start(), nil)
+	gobottest.Assert(t, started, []string{"motor", "led", "lcd"})
+	gobottest.Assert(t, r.Stop(), nil)
+	gobottest.Assert(t, halted, []string{"lcd", "led", "motor"})
+}
+
+func TestRobotHaltStepTimeout(t *testing.T) {
+	var started, halted []string
+	display := &finalizeSignalingAdaptor{testAdaptor: *newTestAdaptor("Display", "/dev/null"), finalized: make(chan bool, 1)}
+	engine := &finalizeSignalingAdaptor{testAdaptor: *newTestAdaptor("Engine", "/dev/null"), finalized: make(chan bool, 1)}
+	lcd := &haltRecordingDriver{halted: &halted, block: make(chan bool)}
+	lcd.startRecordingDriver = startRecordingDriver{testDriver: *newTestDriver(&display.testAdaptor, "lcd", "0"), started: &started}
+	lcd.connection = display
+	motor := &haltRecordingDriver{halted: &halted}
+	motor.startRecordingDriver = startRecordingDriver{testDriver: *newTestDriver(&engine.testAdaptor, "motor", "1"), started: &started}
+	motor.connection = engine
+	r := NewRobot("Robot99", []Connection{engine, display}, []Device{motor, lcd})
+	r.AutoRun = false
+	r.HaltStepTimeout = 20 * time.Millisecond
+	defer close(lcd.block)
+
+	gobottest.Assert(t, r.Start(), nil)
+	begin := time.Now()
+	err := r.Stop()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "halt of device lcd timed out"), true)
+	gobottest.Assert(t, time.Since(begin) < 200*time.Millisecond, true)
+	// the motor is halted although the lcd hangs
+	gobottest.Assert(t, halted, []string{"motor"})
+	gobottest.Assert(t, r.Running(), false)
+	// the connection of the hanging lcd is not finalized
+	gobottest.Assert(t, strings.Contains(err.Error(), "finalize of connection Display skipped, device lcd is still halting"), true)
+	gobottest.Assert(t, len(engine.finalized), 1)
+	gobottest.Assert(t, len(display.finalized), 0)
+}
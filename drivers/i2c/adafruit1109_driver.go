@@ -2,7 +2,6 @@ package i2c
 
 import (
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
@@ -112,7 +111,7 @@ func (d *Adafruit1109Driver) Connection() gobot.Connection { return d.MCP23017Dr
 // Start implements the gobot.Device interface.
 func (d *Adafruit1109Driver) Start() error {
 	if adafruit1109Debug {
-		log.Printf("## MCP.Start ##")
+		gobot.Log().Debug("## MCP.Start ##")
 	}
 	if err := d.MCP23017Driver.Start(); err != nil {
 		return err
@@ -154,7 +153,7 @@ func (d *Adafruit1109Driver) Start() error {
 		return err
 	}
 	if adafruit1109Debug {
-		log.Printf("## HD.Start ##")
+		gobot.Log().Debug("## HD.Start ##")
 	}
 	return d.HD44780Driver.Start()
 }
@@ -207,7 +206,7 @@ func (d *Adafruit1109Driver) DigitalRead(id string) (int, error) {
 // The MCP23017 variant don't support PWM and have inverted logic
 func (d *Adafruit1109Driver) SetRGB(r, g, b bool) error {
 	if adafruit1109Debug {
-		log.Printf("## SetRGB %t, %t, %t ##", r, g, b)
+		gobot.Log().Debug("## SetRGB ##", "r", r, "g", g, "b", b)
 	}
 	rio := d.redPin
 	gio := d.greenPin
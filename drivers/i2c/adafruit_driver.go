@@ -2,7 +2,6 @@ package i2c
 
 import (
 	"errors"
-	"log"
 	"math"
 	"time"
 
@@ -204,12 +203,12 @@ func (a *AdafruitMotorHatDriver) Start() (err error) {
 
 	err = a.startServoHat(bus)
 	if adafruitDebug && err != nil {
-		log.Printf("[adafruit_driver] start servohat error: %s\n", err)
+		gobot.Log().Debug("[adafruit_driver] start servohat failed", "error", err)
 	}
 
 	err = a.startMotorHat(bus)
 	if adafruitDebug && err != nil {
-		log.Printf("[adafruit_driver] start motorhat error: %s\n", err)
+		gobot.Log().Debug("[adafruit_driver] start motorhat failed", "error", err)
 	}
 
 	return
@@ -291,9 +290,8 @@ func (a *AdafruitMotorHatDriver) setPWMFreq(conn Connection, freq float64) (err
 	preScaleVal -= 1.0
 	preScale := math.Floor(preScaleVal + 0.5)
 	if adafruitDebug {
-		log.Printf("Setting PWM frequency to:	%.2f Hz", freq)
-		log.Printf("Estimated pre-scale: 		%.2f", preScaleVal)
-		log.Printf("Final pre-scale: 			%.2f", preScale)
+		gobot.Log().Debug("[adafruit_driver] setting PWM frequency", "frequency", freq,
+			"estimatedPreScale", preScaleVal, "preScale", preScale)
 	}
 	// default (and only) reads register 0
 	oldMode := []byte{0}
@@ -491,9 +489,8 @@ func (a *AdafruitMotorHatDriver) oneStep(motor int, dir AdafruitDirection, style
 		coils = step2coils[(currStep / (stepperMicrosteps / 2))]
 	}
 	if adafruitDebug {
-		log.Printf("[adafruit_driver] currStep: %d, index into step2coils: %d\n",
-			currStep, (currStep / (stepperMicrosteps / 2)))
-		log.Printf("[adafruit_driver] coils state = %v", coils)
+		gobot.Log().Debug("[adafruit_driver] one step", "currStep", currStep,
+			"step2coilsIndex", currStep/(stepperMicrosteps/2), "coils", coils)
 	}
 	if err = a.setPin(a.motorHatConnection, a.stepperMotors[motor].ain2, coils[0]); err != nil {
 		return
@@ -530,7 +527,7 @@ func (a *AdafruitMotorHatDriver) Step(motor, steps int, dir AdafruitDirection, s
 		steps *= stepperMicrosteps
 	}
 	if adafruitDebug {
-		log.Printf("[adafruit_driver] %f seconds per step", secPerStep)
+		gobot.Log().Debug("[adafruit_driver] step timing", "secondsPerStep", secPerStep)
 	}
 	for i := 0; i < steps; i++ {
 		if latestStep, err = a.oneStep(motor, dir, style); err != nil {
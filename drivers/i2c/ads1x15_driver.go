@@ -1,13 +1,14 @@
 package i2c
 
 import (
-	"log"
 	"math"
 	"sort"
 	"strconv"
 	"time"
 
 	"fmt"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const ads1x15DefaultAddress = 0x48
@@ -162,7 +163,7 @@ func WithADS1x15BestGainForVoltage(voltage float64) func(Config) {
 			}
 			WithADS1x15Gain(bestGain)(d)
 		} else if ads1x15Debug {
-			log.Printf("Trying to set best gain for voltage for non-ADS1x15Driver %v", c)
+			gobot.Log().Debug("Trying to set best gain for voltage for non-ADS1x15Driver", "config", c)
 		}
 	}
 }
@@ -179,7 +180,7 @@ func WithADS1x15ChannelBestGainForVoltage(channel int, voltage float64) func(Con
 			}
 			WithADS1x15ChannelGain(channel, bestGain)(d)
 		} else if ads1x15Debug {
-			log.Printf("Trying to set channel best gain for voltage for non-ADS1x15Driver %v", c)
+			gobot.Log().Debug("Trying to set channel best gain for voltage for non-ADS1x15Driver", "config", c)
 		}
 	}
 }
@@ -196,7 +197,7 @@ func WithADS1x15Gain(val int) func(Config) {
 			}
 			d.setChannelGains(val)
 		} else if ads1x15Debug {
-			log.Printf("Trying to set gain for non-ADS1x15Driver %v", c)
+			gobot.Log().Debug("Trying to set gain for non-ADS1x15Driver", "config", c)
 		}
 	}
 }
@@ -216,7 +217,7 @@ func WithADS1x15ChannelGain(channel int, val int) func(Config) {
 			}
 			d.channelCfgs[channel].gain = val
 		} else if ads1x15Debug {
-			log.Printf("Trying to set channel gain for non-ADS1x15Driver %v", c)
+			gobot.Log().Debug("Trying to set channel gain for non-ADS1x15Driver", "config", c)
 		}
 	}
 }
@@ -233,7 +234,7 @@ func WithADS1x15DataRate(val int) func(Config) {
 			}
 			d.setChannelDataRates(val)
 		} else if ads1x15Debug {
-			log.Printf("Trying to set data rate for non-ADS1x15Driver %v", c)
+			gobot.Log().Debug("Trying to set data rate for non-ADS1x15Driver", "config", c)
 		}
 	}
 }
@@ -253,7 +254,7 @@ func WithADS1x15ChannelDataRate(channel int, val int) func(Config) {
 			}
 			d.channelCfgs[channel].dataRate = val
 		} else if ads1x15Debug {
-			log.Printf("Trying to set channel data rate for non-ADS1x15Driver %v", c)
+			gobot.Log().Debug("Trying to set channel data rate for non-ADS1x15Driver", "config", c)
 		}
 	}
 }
@@ -271,7 +272,7 @@ func WithADS1x15WaitSingleCycle() func(Config) {
 		if ok {
 			d.waitOnlyOneCycle = true
 		} else if ads1x15Debug {
-			log.Printf("Trying to set wait single cycle for non-ADS1x15Driver %v", c)
+			gobot.Log().Debug("Trying to set wait single cycle for non-ADS1x15Driver", "config", c)
 		}
 	}
 }
@@ -450,7 +451,7 @@ func (d *ADS1x15Driver) waitForConversionFinished(delay time.Duration) (err erro
 			return
 		}
 		if ads1x15Debug {
-			log.Printf("ADS1x15Driver: config register state: 0x%X\n", data)
+			gobot.Log().Debug("ADS1x15Driver: config register state", "state", fmt.Sprintf("0x%X", data))
 		}
 		// the highest bit 15: 0-device perform a conversion, 1-no conversion in progress
 		if data&ads1x15ConfigOsSingle > 0 {
@@ -464,7 +465,7 @@ func (d *ADS1x15Driver) waitForConversionFinished(delay time.Duration) (err erro
 
 	if ads1x15Debug {
 		elapsed := time.Since(start)
-		log.Printf("conversion takes %s", elapsed)
+		gobot.Log().Debug("ADS1x15Driver: conversion done", "elapsed", elapsed)
 	}
 
 	return
@@ -3,7 +3,8 @@ package i2c
 import (
 	"encoding/binary"
 	"fmt"
-	"log"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const adxl345Debug = false
@@ -154,7 +155,7 @@ func WithADXL345LowPowerMode(val bool) func(Config) {
 		if d, ok := c.(*ADXL345Driver); ok {
 			d.bwRate.lowPower = val
 		} else if adxl345Debug {
-			log.Printf("Trying to modify low power mode for non-ADXL345Driver %v", c)
+			gobot.Log().Debug("Trying to modify low power mode for non-ADXL345Driver", "config", c)
 		}
 	}
 }
@@ -166,7 +167,7 @@ func WithADXL345DataOutputRate(val ADXL345RateConfig) func(Config) {
 		if d, ok := c.(*ADXL345Driver); ok {
 			d.bwRate.rate = val
 		} else if adxl345Debug {
-			log.Printf("Trying to set data output rate for non-ADXL345Driver %v", c)
+			gobot.Log().Debug("Trying to set data output rate for non-ADXL345Driver", "config", c)
 		}
 	}
 }
@@ -178,7 +179,7 @@ func WithADXL345FullScaleRange(val ADXL345FsRangeConfig) func(Config) {
 		if d, ok := c.(*ADXL345Driver); ok {
 			d.dataFormat.fullScaleRange = val
 		} else if adxl345Debug {
-			log.Printf("Trying to set full scale range for non-ADXL345Driver %v", c)
+			gobot.Log().Debug("Trying to set full scale range for non-ADXL345Driver", "config", c)
 		}
 	}
 }
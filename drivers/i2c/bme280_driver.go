@@ -4,7 +4,8 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
-	"log"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const bme280Debug = true
@@ -81,7 +82,7 @@ func WithBME280PressureOversampling(val BMP280PressureOversampling) func(Config)
 		if d, ok := c.(*BME280Driver); ok {
 			d.ctrlPressOversamp = val
 		} else if bme280Debug {
-			log.Printf("Trying to set pressure oversampling for non-BME280Driver %v", c)
+			gobot.Log().Debug("Trying to set pressure oversampling for non-BME280Driver", "config", c)
 		}
 	}
 }
@@ -93,7 +94,7 @@ func WithBME280TemperatureOversampling(val BMP280TemperatureOversampling) func(C
 		if d, ok := c.(*BME280Driver); ok {
 			d.ctrlTempOversamp = val
 		} else if bme280Debug {
-			log.Printf("Trying to set temperature oversampling for non-BME280Driver %v", c)
+			gobot.Log().Debug("Trying to set temperature oversampling for non-BME280Driver", "config", c)
 		}
 	}
 }
@@ -105,7 +106,7 @@ func WithBME280IIRFilter(val BMP280IIRFilter) func(Config) {
 		if d, ok := c.(*BME280Driver); ok {
 			d.confFilter = val
 		} else if bme280Debug {
-			log.Printf("Trying to set IIR filter for non-BME280Driver %v", c)
+			gobot.Log().Debug("Trying to set IIR filter for non-BME280Driver", "config", c)
 		}
 	}
 }
@@ -117,7 +118,7 @@ func WithBME280HumidityOversampling(val BME280HumidityOversampling) func(Config)
 		if d, ok := c.(*BME280Driver); ok {
 			d.ctrlHumOversamp = val
 		} else if bme280Debug {
-			log.Printf("Trying to set humidity oversampling for non-BME280Driver %v", c)
+			gobot.Log().Debug("Trying to set humidity oversampling for non-BME280Driver", "config", c)
 		}
 	}
 }
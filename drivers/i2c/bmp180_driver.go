@@ -3,8 +3,9 @@ package i2c
 import (
 	"bytes"
 	"encoding/binary"
-	"log"
 	"time"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const bmp180Debug = false
@@ -89,7 +90,7 @@ func WithBMP180OversamplingMode(val BMP180OversamplingMode) func(Config) {
 		if d, ok := c.(*BMP180Driver); ok {
 			d.oversampling = val
 		} else if bmp180Debug {
-			log.Printf("Trying to set oversampling mode for non-BMP180Driver %v", c)
+			gobot.Log().Debug("Trying to set oversampling mode for non-BMP180Driver", "config", c)
 		}
 	}
 }
@@ -3,8 +3,9 @@ package i2c
 import (
 	"bytes"
 	"encoding/binary"
-	"log"
 	"math"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const bmp280Debug = true
@@ -129,7 +130,7 @@ func WithBMP280PressureOversampling(val BMP280PressureOversampling) func(Config)
 		if d, ok := c.(*BMP280Driver); ok {
 			d.ctrlPressOversamp = val
 		} else if bmp280Debug {
-			log.Printf("Trying to set pressure oversampling for non-BMP280Driver %v", c)
+			gobot.Log().Debug("Trying to set pressure oversampling for non-BMP280Driver", "config", c)
 		}
 	}
 }
@@ -141,7 +142,7 @@ func WithBMP280TemperatureOversampling(val BMP280TemperatureOversampling) func(C
 		if d, ok := c.(*BMP280Driver); ok {
 			d.ctrlTempOversamp = val
 		} else if bmp280Debug {
-			log.Printf("Trying to set temperature oversampling for non-BMP280Driver %v", c)
+			gobot.Log().Debug("Trying to set temperature oversampling for non-BMP280Driver", "config", c)
 		}
 	}
 }
@@ -153,7 +154,7 @@ func WithBMP280IIRFilter(val BMP280IIRFilter) func(Config) {
 		if d, ok := c.(*BMP280Driver); ok {
 			d.confFilter = val
 		} else if bmp280Debug {
-			log.Printf("Trying to set IIR filter for non-BMP280Driver %v", c)
+			gobot.Log().Debug("Trying to set IIR filter for non-BMP280Driver", "config", c)
 		}
 	}
 }
@@ -4,8 +4,9 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"log"
 	"math"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const bmp388Debug = false
@@ -124,7 +125,7 @@ func WithBMP388IIRFilter(val BMP388IIRFilter) func(Config) {
 		if d, ok := c.(*BMP388Driver); ok {
 			d.confFilter = val
 		} else if bmp388Debug {
-			log.Printf("Trying to set IIR filter for non-BMP388Driver %v", c)
+			gobot.Log().Debug("Trying to set IIR filter for non-BMP388Driver", "config", c)
 		}
 	}
 }
@@ -2,9 +2,10 @@ package i2c
 
 import (
 	"fmt"
-	"log"
 	"math"
 	"sort"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const (
@@ -148,7 +149,7 @@ func WithHMC5883LSamplesAveraged(val int) func(Config) {
 			}
 			d.samplesAvg = uint8(val)
 		} else if hmc5883lDebug {
-			log.Printf("Trying to set samples averaged for non-HMC5883LDriver %v", c)
+			gobot.Log().Debug("Trying to set samples averaged for non-HMC5883LDriver", "config", c)
 		}
 	}
 }
@@ -164,7 +165,7 @@ func WithHMC5883LDataOutputRate(val int) func(Config) {
 			}
 			d.outputRate = uint32(val)
 		} else if hmc5883lDebug {
-			log.Printf("Trying to set data output rate for non-HMC5883LDriver %v", c)
+			gobot.Log().Debug("Trying to set data output rate for non-HMC5883LDriver", "config", c)
 		}
 	}
 }
@@ -180,7 +181,7 @@ func WithHMC5883LApplyBias(val int) func(Config) {
 			}
 			d.applyBias = int8(val)
 		} else if hmc5883lDebug {
-			log.Printf("Trying to set measurement flow for non-HMC5883LDriver %v", c)
+			gobot.Log().Debug("Trying to set measurement flow for non-HMC5883LDriver", "config", c)
 		}
 	}
 }
@@ -196,7 +197,7 @@ func WithHMC5883LGain(val int) func(Config) {
 			}
 			d.gain = float64(val)
 		} else if hmc5883lDebug {
-			log.Printf("Trying to set gain for non-HMC5883LDriver %v", c)
+			gobot.Log().Debug("Trying to set gain for non-HMC5883LDriver", "config", c)
 		}
 	}
 }
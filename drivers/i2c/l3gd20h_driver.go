@@ -3,7 +3,8 @@ package i2c
 import (
 	"bytes"
 	"encoding/binary"
-	"log"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const (
@@ -83,7 +84,7 @@ func WithL3GD20HFullScaleRange(val L3GD20HScale) func(Config) {
 		if ok {
 			d.scale = val
 		} else if l3gd20hDebug {
-			log.Printf("Trying to set full scale range of gyroscope for non-L3GD20HDriver %v", c)
+			gobot.Log().Debug("Trying to set full scale range of gyroscope for non-L3GD20HDriver", "config", c)
 		}
 	}
 }
@@ -2,7 +2,6 @@ package i2c
 
 import (
 	"fmt"
-	"log"
 	"strings"
 
 	"gobot.io/x/gobot/v2"
@@ -114,7 +113,7 @@ func WithMCP23017Bank(val uint8) func(Config) {
 		if ok {
 			d.mcpConf.bank = val
 		} else if mcp23017Debug {
-			log.Printf("trying to set bank for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("trying to set bank for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -126,7 +125,7 @@ func WithMCP23017Mirror(val uint8) func(Config) {
 		if ok {
 			d.mcpConf.mirror = val
 		} else if mcp23017Debug {
-			log.Printf("Trying to set mirror for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("Trying to set mirror for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -138,7 +137,7 @@ func WithMCP23017Seqop(val uint8) func(Config) {
 		if ok {
 			d.mcpConf.seqop = val
 		} else if mcp23017Debug {
-			log.Printf("Trying to set seqop for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("Trying to set seqop for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -150,7 +149,7 @@ func WithMCP23017Disslw(val uint8) func(Config) {
 		if ok {
 			d.mcpConf.disslw = val
 		} else if mcp23017Debug {
-			log.Printf("Trying to set disslw for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("Trying to set disslw for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -163,7 +162,7 @@ func WithMCP23017Haen(val uint8) func(Config) {
 		if ok {
 			d.mcpConf.haen = val
 		} else if mcp23017Debug {
-			log.Printf("Trying to set haen for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("Trying to set haen for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -175,7 +174,7 @@ func WithMCP23017Odr(val uint8) func(Config) {
 		if ok {
 			d.mcpConf.odr = val
 		} else if mcp23017Debug {
-			log.Printf("Trying to set odr for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("Trying to set odr for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -187,7 +186,7 @@ func WithMCP23017Intpol(val uint8) func(Config) {
 		if ok {
 			d.mcpConf.intpol = val
 		} else if mcp23017Debug {
-			log.Printf("Trying to set intpol for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("Trying to set intpol for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -203,7 +202,7 @@ func WithMCP23017ForceRefresh(val uint8) func(Config) {
 		if ok {
 			d.mcpBehav.forceRefresh = val > 0
 		} else if mcp23017Debug {
-			log.Printf("Trying to set forceRefresh for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("Trying to set forceRefresh for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -220,7 +219,7 @@ func WithMCP23017AutoIODirOff(val uint8) func(Config) {
 		if ok {
 			d.mcpBehav.autoIODirOff = val > 0
 		} else if mcp23017Debug {
-			log.Printf("Trying to set autoIODirOff for non-MCP23017Driver %v", c)
+			gobot.Log().Debug("Trying to set autoIODirOff for non-MCP23017Driver", "config", c)
 		}
 	}
 }
@@ -334,16 +333,18 @@ func (m *MCP23017Driver) write(reg uint8, pin uint8, state bitState) (err error)
 
 	if val != valOrg || m.mcpBehav.forceRefresh {
 		if mcp23017Debug {
-			log.Printf("write done: MCP forceRefresh: %t, address: 0x%X, register: 0x%X, name: %s, value: 0x%X\n",
-				m.mcpBehav.forceRefresh, m.GetAddressOrDefault(mcp23017DefaultAddress), reg, m.getRegName(reg), val)
+			gobot.Log().Debug("MCP write done", "forceRefresh", m.mcpBehav.forceRefresh,
+				"address", fmt.Sprintf("0x%X", m.GetAddressOrDefault(mcp23017DefaultAddress)),
+				"register", fmt.Sprintf("0x%X", reg), "name", m.getRegName(reg), "value", fmt.Sprintf("0x%X", val))
 		}
 		if err = m.connection.WriteByteData(reg, val); err != nil {
 			return fmt.Errorf("MCP write-WriteByteData(reg=%d,val=%d): %v", reg, val, err)
 		}
 	} else {
 		if mcp23017Debug {
-			log.Printf("write skipped: MCP forceRefresh: %t, address: 0x%X, register: 0x%X, name: %s, value: 0x%X\n",
-				m.mcpBehav.forceRefresh, m.GetAddressOrDefault(mcp23017DefaultAddress), reg, m.getRegName(reg), val)
+			gobot.Log().Debug("MCP write skipped", "forceRefresh", m.mcpBehav.forceRefresh,
+				"address", fmt.Sprintf("0x%X", m.GetAddressOrDefault(mcp23017DefaultAddress)),
+				"register", fmt.Sprintf("0x%X", reg), "name", m.getRegName(reg), "value", fmt.Sprintf("0x%X", val))
 		}
 	}
 	return nil
@@ -357,8 +358,9 @@ func (m *MCP23017Driver) read(reg uint8) (val uint8, err error) {
 		return val, fmt.Errorf("MCP write-ReadByteData(reg=%d): %v", reg, err)
 	}
 	if mcp23017Debug {
-		log.Printf("reading done: MCP autoIODirOff: %t, address: 0x%X, register:0x%X, name: %s, value: 0x%X\n",
-			m.mcpBehav.autoIODirOff, m.GetAddressOrDefault(mcp23017DefaultAddress), reg, m.getRegName(reg), val)
+		gobot.Log().Debug("MCP reading done", "autoIODirOff", m.mcpBehav.autoIODirOff,
+			"address", fmt.Sprintf("0x%X", m.GetAddressOrDefault(mcp23017DefaultAddress)),
+			"register", fmt.Sprintf("0x%X", reg), "name", m.getRegName(reg), "value", fmt.Sprintf("0x%X", val))
 	}
 	return val, nil
 }
@@ -4,8 +4,9 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"log"
 	"time"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const (
@@ -154,7 +155,7 @@ func WithMPU6050DigitalFilter(val MPU6050DlpfConfig) func(Config) {
 		if d, ok := c.(*MPU6050Driver); ok {
 			d.dlpf = val
 		} else if mpu6050Debug {
-			log.Printf("Trying to set digital low pass filter for non-MPU6050Driver %v", c)
+			gobot.Log().Debug("Trying to set digital low pass filter for non-MPU6050Driver", "config", c)
 		}
 	}
 }
@@ -166,7 +167,7 @@ func WithMPU6050FrameSync(val MPU6050FrameSyncConfig) func(Config) {
 		if d, ok := c.(*MPU6050Driver); ok {
 			d.frameSync = val
 		} else if mpu6050Debug {
-			log.Printf("Trying to set external frame synchronization for non-MPU6050Driver %v", c)
+			gobot.Log().Debug("Trying to set external frame synchronization for non-MPU6050Driver", "config", c)
 		}
 	}
 }
@@ -178,7 +179,7 @@ func WithMPU6050AccelFullScaleRange(val MPU6050AccelFsConfig) func(Config) {
 		if d, ok := c.(*MPU6050Driver); ok {
 			d.accelFs = val
 		} else if mpu6050Debug {
-			log.Printf("Trying to set full scale range of accelerometer for non-MPU6050Driver %v", c)
+			gobot.Log().Debug("Trying to set full scale range of accelerometer for non-MPU6050Driver", "config", c)
 		}
 	}
 }
@@ -190,7 +191,7 @@ func WithMPU6050GyroFullScaleRange(val MPU6050GyroFsConfig) func(Config) {
 		if d, ok := c.(*MPU6050Driver); ok {
 			d.gyroFs = val
 		} else if mpu6050Debug {
-			log.Printf("Trying to set full scale range of gyroscope for non-MPU6050Driver %v", c)
+			gobot.Log().Debug("Trying to set full scale range of gyroscope for non-MPU6050Driver", "config", c)
 		}
 	}
 }
@@ -202,7 +203,7 @@ func WithMPU6050ClockSource(val MPU6050Pwr1ClockConfig) func(Config) {
 		if d, ok := c.(*MPU6050Driver); ok {
 			d.clock = val
 		} else if mpu6050Debug {
-			log.Printf("Trying to set clock source for non-MPU6050Driver %v", c)
+			gobot.Log().Debug("Trying to set clock source for non-MPU6050Driver", "config", c)
 		}
 	}
 }
@@ -214,7 +215,7 @@ func WithMPU6050Gravity(val float64) func(Config) {
 		if d, ok := c.(*MPU6050Driver); ok {
 			d.gravity = val
 		} else if mpu6050Debug {
-			log.Printf("Trying to set gravity for non-MPU6050Driver %v", c)
+			gobot.Log().Debug("Trying to set gravity for non-MPU6050Driver", "config", c)
 		}
 	}
 }
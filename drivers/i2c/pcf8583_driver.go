@@ -2,8 +2,9 @@ package i2c
 
 import (
 	"fmt"
-	"log"
 	"time"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const (
@@ -128,7 +129,7 @@ func WithPCF8583Mode(mode PCF8583Control) func(Config) {
 			}
 			d.mode = mode
 		} else if pcf8583Debug {
-			log.Printf("trying to set mode for non-PCF8583Driver %v", c)
+			gobot.Log().Debug("trying to set mode for non-PCF8583Driver", "config", c)
 		}
 	}
 }
@@ -298,9 +299,9 @@ func (d *PCF8583Driver) initialize() error {
 		}
 		if pcf8583Debug {
 			if PCF8583Control(ctrlRegVal).isCounterMode() {
-				log.Printf("%s switched to counter mode 0x%02x", d.name, ctrlRegVal)
+				gobot.Log().Debug("switched to counter mode", "name", d.name, "ctrlReg", fmt.Sprintf("0x%02x", ctrlRegVal))
 			} else {
-				log.Printf("%s switched to clock mode 0x%02x", d.name, ctrlRegVal)
+				gobot.Log().Debug("switched to clock mode", "name", d.name, "ctrlReg", fmt.Sprintf("0x%02x", ctrlRegVal))
 			}
 		}
 	}
@@ -326,7 +327,7 @@ func pcf8583encodeBcd(val byte) byte {
 	if val > 99 {
 		val = 99
 		if pcf8583Debug {
-			log.Printf("PCF8583 BCD value (%d) exceeds limit of 99, now limited.", val)
+			gobot.Log().Debug("PCF8583 BCD value exceeds limit of 99, now limited", "value", val)
 		}
 	}
 	hi, lo := byte(val/10), byte(val%10)
@@ -339,13 +340,13 @@ func pcf8583decodeBcd(bcd byte) byte {
 	if hi > 9 {
 		hi = 9
 		if pcf8583Debug {
-			log.Printf("PCF8583 BCD value (%02x) exceeds limit 0x99 on most significant digit, now limited", bcd)
+			gobot.Log().Debug("PCF8583 BCD value exceeds limit 0x99 on most significant digit, now limited", "bcd", fmt.Sprintf("%02x", bcd))
 		}
 	}
 	if lo > 9 {
 		lo = 9
 		if pcf8583Debug {
-			log.Printf("PCF8583 BCD value (%02x) exceeds limit 0x99 on least significant digit, now limited", bcd)
+			gobot.Log().Debug("PCF8583 BCD value exceeds limit 0x99 on least significant digit, now limited", "bcd", fmt.Sprintf("%02x", bcd))
 		}
 	}
 	return 10*hi + lo
@@ -2,9 +2,10 @@ package i2c
 
 import (
 	"fmt"
-	"log"
 	"strings"
 	"time"
+
+	"gobot.io/x/gobot/v2"
 )
 
 // PCF8591 supports addresses from 0x48 to 0x4F
@@ -144,10 +145,10 @@ func WithPCF8591With400kbitStabilization(additionalReadWrite, additionalRead int
 			p.additionalReadWrite = uint8(additionalReadWrite)
 			p.additionalRead = uint8(additionalRead)
 			if pcf8591Debug {
-				log.Printf("400 kbit stabilization for PCF8591Driver set rw: %d, r: %d", p.additionalReadWrite, p.additionalRead)
+				gobot.Log().Debug("400 kbit stabilization for PCF8591Driver set", "rw", p.additionalReadWrite, "r", p.additionalRead)
 			}
 		} else if pcf8591Debug {
-			log.Printf("trying to set 400 kbit stabilization for non-PCF8591Driver %v", c)
+			gobot.Log().Debug("trying to set 400 kbit stabilization for non-PCF8591Driver", "config", c)
 		}
 	}
 }
@@ -163,7 +164,7 @@ func WithPCF8591ForceRefresh(val uint8) func(Config) {
 		if ok {
 			d.forceRefresh = val > 0
 		} else if pcf8591Debug {
-			log.Printf("Trying to set forceRefresh for non-PCF8591Driver %v", c)
+			gobot.Log().Debug("Trying to set forceRefresh for non-PCF8591Driver", "config", c)
 		}
 	}
 }
@@ -252,7 +253,7 @@ func (p *PCF8591Driver) AnalogWrite(pin string, value int) (err error) {
 
 	if p.lastAnaOut == byteVal {
 		if pcf8591Debug {
-			log.Printf("write skipped because value unchanged: 0x%X\n", byteVal)
+			gobot.Log().Debug("write skipped because value unchanged", "value", fmt.Sprintf("0x%X", byteVal))
 		}
 		return nil
 	}
@@ -303,7 +304,7 @@ func (p *PCF8591Driver) writeCtrlByte(ctrlByte uint8, forceRefresh bool) error {
 		p.lastCtrlByte = ctrlByte
 	} else {
 		if pcf8591Debug {
-			log.Printf("write skipped because control byte unchanged: 0x%X\n", ctrlByte)
+			gobot.Log().Debug("write skipped because control byte unchanged", "ctrlByte", fmt.Sprintf("0x%X", ctrlByte))
 		}
 	}
 	return nil
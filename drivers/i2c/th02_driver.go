@@ -24,8 +24,9 @@ package i2c
 
 import (
 	"fmt"
-	"log"
 	"time"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const (
@@ -98,7 +99,7 @@ func WithTH02FastMode(val int) func(Config) {
 		if ok {
 			d.fastMode = (val > 0)
 		} else if th02Debug {
-			log.Printf("Trying to set fast mode for non-TH02Driver %v", c)
+			gobot.Log().Debug("Trying to set fast mode for non-TH02Driver", "config", c)
 		}
 	}
 }
@@ -2,7 +2,6 @@ package i2c
 
 import (
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
@@ -154,7 +153,7 @@ func WithYL40Interval(pin YL40Pin, val time.Duration) func(Config) {
 				sensor.interval = val
 			}
 		} else if yl40Debug {
-			log.Printf("trying to set interval for '%s' refresh for non-YL40Driver %v", pin, c)
+			gobot.Log().Debug("trying to set interval for refresh for non-YL40Driver", "pin", pin, "config", c)
 		}
 	}
 }
@@ -168,7 +167,7 @@ func WithYL40InputScaler(pin YL40Pin, scaler func(input int) (value float64)) fu
 				sensor.scaler = scaler
 			}
 		} else if yl40Debug {
-			log.Printf("trying to set input scaler for '%s' for non-YL40Driver %v", pin, c)
+			gobot.Log().Debug("trying to set input scaler for non-YL40Driver", "pin", pin, "config", c)
 		}
 	}
 }
@@ -180,7 +179,7 @@ func WithYL40OutputScaler(scaler func(input float64) (value int)) func(Config) {
 		if ok {
 			y.conf.aOutScaler = scaler
 		} else if yl40Debug {
-			log.Printf("trying to set output scaler for '%s' for non-YL40Driver %v", YL40AOUT, c)
+			gobot.Log().Debug("trying to set output scaler for non-YL40Driver", "pin", YL40AOUT, "config", c)
 		}
 	}
 }
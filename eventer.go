@@ -11,8 +11,8 @@ type eventer struct {
 	// new events get put in to the event channel
 	in eventChannel
 
-	// map of out channels used by subscribers
-	outs map[eventChannel]eventChannel
+	// map of out channels used by subscribers, with their backpressure policy
+	outs map[eventChannel]EventPolicy
 
 	// mutex to protect the eventChannel map
 	eventsMutex sync.Mutex
@@ -20,6 +20,19 @@ type eventer struct {
 
 const eventChanBufferSize = 10
 
+// EventPolicy describes what Publish does when a subscriber's channel is full.
+type EventPolicy int
+
+const (
+	// EventBlock waits until the subscriber has room for the event. This is the
+	// policy used by Subscribe.
+	EventBlock EventPolicy = iota
+	// EventDropNewest discards the event being published.
+	EventDropNewest
+	// EventDropOldest discards the oldest queued event to make room for the new one.
+	EventDropOldest
+)
+
 // Eventer is the interface which describes how a Driver or Adaptor
 // handles events.
 type Eventer interface {
@@ -52,12 +65,21 @@ type Eventer interface {
 	Once(name string, f func(s interface{})) (err error)
 }
 
+// BufferedSubscriber is the interface of an Eventer which supports subscriptions
+// with a custom channel size and policy for a full channel. The Eventer returned
+// by NewEventer implements it.
+type BufferedSubscriber interface {
+	// SubscribeBuffered subscribes to events using a channel of the given size
+	// and the given policy for a full channel
+	SubscribeBuffered(size int, policy EventPolicy) (events eventChannel)
+}
+
 // NewEventer returns a new Eventer.
 func NewEventer() Eventer {
 	evtr := &eventer{
 		eventnames: make(map[string]string),
 		in:         make(eventChannel, eventChanBufferSize),
-		outs:       make(map[eventChannel]eventChannel),
+		outs:       make(map[eventChannel]EventPolicy),
 	}
 
 	// goroutine to cascade "in" events to all "out" event channels
@@ -65,8 +87,8 @@ func NewEventer() Eventer {
 		for {
 			evt := <-evtr.in
 			evtr.eventsMutex.Lock()
-			for _, out := range evtr.outs {
-				out <- evt
+			for out, policy := range evtr.outs {
+				deliver(out, policy, evt)
 			}
 			evtr.eventsMutex.Unlock()
 		}
@@ -104,10 +126,21 @@ func (e *eventer) Publish(name string, data interface{}) {
 
 // Subscribe to any events from this eventer
 func (e *eventer) Subscribe() eventChannel {
+	return e.SubscribeBuffered(eventChanBufferSize, EventBlock)
+}
+
+// SubscribeBuffered subscribes to any events from this eventer using a channel
+// which holds up to size events. A negative size is treated as zero, which
+// gives an unbuffered channel. The policy decides whether a full channel
+// blocks the publisher or drops events.
+func (e *eventer) SubscribeBuffered(size int, policy EventPolicy) eventChannel {
+	if size < 0 {
+		size = 0
+	}
 	e.eventsMutex.Lock()
 	defer e.eventsMutex.Unlock()
-	out := make(eventChannel, eventChanBufferSize)
-	e.outs[out] = out
+	out := make(eventChannel, size)
+	e.outs[out] = policy
 	return out
 }
 
@@ -149,3 +182,33 @@ func (e *eventer) Once(n string, f func(s interface{})) (err error) {
 
 	return
 }
+
+// deliver puts evt into out, honoring the given policy if out is full
+func deliver(out eventChannel, policy EventPolicy, evt *Event) {
+	switch policy {
+	case EventDropNewest:
+		select {
+		case out <- evt:
+		default:
+		}
+	case EventDropOldest:
+		// an unbuffered channel has nothing to drop, so never wait on it
+		for cap(out) > 0 {
+			select {
+			case out <- evt:
+				return
+			default:
+			}
+			select {
+			case <-out:
+			default:
+			}
+		}
+		select {
+		case out <- evt:
+		default:
+		}
+	default:
+		out <- evt
+	}
+}
@@ -76,3 +76,73 @@ func TestEventerOnce(t *testing.T) {
 	case <-time.After(10 * time.Millisecond):
 	}
 }
+
+func TestEventerSubscribeBufferedDropNewest(t *testing.T) {
+	e := NewEventer()
+	e.AddEvent("test")
+
+	out := e.(BufferedSubscriber).SubscribeBuffered(2, EventDropNewest)
+	sync := e.(BufferedSubscriber).SubscribeBuffered(0, EventBlock)
+	go func() {
+		for i := 0; i < 5; i++ {
+			e.Publish("test", i)
+		}
+	}()
+	for i := 0; i < 5; i++ {
+		<-sync
+	}
+	// the dispatcher holds the lock until the last event is passed to all
+	// subscribers, so unsubscribing waits for that
+	e.Unsubscribe(sync)
+
+	gobottest.Assert(t, len(out), 2)
+	gobottest.Assert(t, (<-out).Data, 0)
+	gobottest.Assert(t, (<-out).Data, 1)
+}
+
+func TestEventerSubscribeBufferedDropOldest(t *testing.T) {
+	e := NewEventer()
+	e.AddEvent("test")
+
+	out := e.(BufferedSubscriber).SubscribeBuffered(2, EventDropOldest)
+	sync := e.(BufferedSubscriber).SubscribeBuffered(0, EventBlock)
+	go func() {
+		for i := 0; i < 5; i++ {
+			e.Publish("test", i)
+		}
+	}()
+	for i := 0; i < 5; i++ {
+		<-sync
+	}
+	e.Unsubscribe(sync)
+
+	gobottest.Assert(t, len(out), 2)
+	gobottest.Assert(t, (<-out).Data, 3)
+	gobottest.Assert(t, (<-out).Data, 4)
+}
+
+func TestEventerSubscribeBufferedSlowConsumer(t *testing.T) {
+	e := NewEventer()
+	e.AddEvent("test")
+
+	slow := e.(BufferedSubscriber).SubscribeBuffered(1, EventDropNewest)
+	fast := e.(BufferedSubscriber).SubscribeBuffered(0, EventBlock)
+	go func() {
+		for i := 0; i < 5; i++ {
+			e.Publish("test", i)
+		}
+	}()
+
+	// the unbuffered subscriber receives all events although nobody reads slow
+	for i := 0; i < 5; i++ {
+		gobottest.Assert(t, (<-fast).Data, i)
+	}
+	e.Unsubscribe(fast)
+	gobottest.Assert(t, len(slow), 1)
+}
+
+func TestEventerSubscribeBufferedNegativeSize(t *testing.T) {
+	e := NewEventer()
+	out := e.(BufferedSubscriber).SubscribeBuffered(-1, EventDropNewest)
+	gobottest.Assert(t, cap(out), 0)
+}
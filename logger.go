@@ -0,0 +1,93 @@
+package gobot
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+)
+
+// Logger is the interface used by gobot core, the API and platforms for all
+// logging. The arguments following the message are alternating keys and
+// values. The interface is satisfied by *slog.Logger, so an application
+// built with Go 1.21 or later can pass e.g. slog.Default() to SetLogger.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// loggerHolder wraps the Logger, because atomic.Value needs a consistent type
+type loggerHolder struct {
+	Logger
+}
+
+var logger atomic.Value
+
+func init() {
+	logger.Store(loggerHolder{NewStdLogger(false)})
+}
+
+// SetLogger replaces the Logger used by gobot. Passing nil restores the
+// default Logger, which writes to the standard log package.
+func SetLogger(l Logger) {
+	if l == nil {
+		l = NewStdLogger(false)
+	}
+	logger.Store(loggerHolder{l})
+}
+
+// Log returns the Logger used by gobot.
+func Log() Logger {
+	return logger.Load().(loggerHolder).Logger
+}
+
+// stdLogger is a Logger writing to the standard log package
+type stdLogger struct {
+	debug bool
+}
+
+// NewStdLogger returns a Logger which writes to the standard log package.
+// Key value pairs are appended to the message as "key=value". Debug messages
+// are dropped unless debug is true.
+func NewStdLogger(debug bool) Logger {
+	return &stdLogger{debug: debug}
+}
+
+// Debug logs the message with the prefix "DEBUG: " if enabled
+func (l *stdLogger) Debug(msg string, args ...interface{}) {
+	if l.debug {
+		l.print("DEBUG: ", msg, args)
+	}
+}
+
+// Info logs the message
+func (l *stdLogger) Info(msg string, args ...interface{}) {
+	l.print("", msg, args)
+}
+
+// Warn logs the message with the prefix "WARNING: "
+func (l *stdLogger) Warn(msg string, args ...interface{}) {
+	l.print("WARNING: ", msg, args)
+}
+
+// Error logs the message with the prefix "ERROR: "
+func (l *stdLogger) Error(msg string, args ...interface{}) {
+	l.print("ERROR: ", msg, args)
+}
+
+func (l *stdLogger) print(prefix string, msg string, args []interface{}) {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteString(msg)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 < len(args) {
+			fmt.Fprintf(&sb, " %v=%v", args[i], args[i+1])
+		} else {
+			// a key without value, like slog does
+			fmt.Fprintf(&sb, " !BADKEY=%v", args[i])
+		}
+	}
+	log.Println(sb.String())
+}
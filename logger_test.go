@@ -0,0 +1,56 @@
+package gobot
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"gobot.io/x/gobot/v2/gobottest"
+)
+
+type testLogger struct {
+	msgs []string
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.msgs = append(l.msgs, "debug "+msg) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.msgs = append(l.msgs, "info "+msg) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.msgs = append(l.msgs, "warn "+msg) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.msgs = append(l.msgs, "error "+msg) }
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	l := NewStdLogger(false)
+	l.Info("Starting Robot...", "name", "bot", "pin", 5)
+	l.Warn("insecure")
+	l.Error("failed", "error", errors.New("timeout"), "odd")
+	l.Debug("hidden")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	gobottest.Assert(t, len(lines), 3)
+	gobottest.Assert(t, strings.HasSuffix(lines[0], "Starting Robot... name=bot pin=5"), true)
+	gobottest.Assert(t, strings.HasSuffix(lines[1], "WARNING: insecure"), true)
+	gobottest.Assert(t, strings.HasSuffix(lines[2], "ERROR: failed error=timeout !BADKEY=odd"), true)
+
+	buf.Reset()
+	NewStdLogger(true).Debug("shown")
+	gobottest.Assert(t, strings.HasSuffix(strings.TrimSpace(buf.String()), "DEBUG: shown"), true)
+}
+
+func TestSetLogger(t *testing.T) {
+	l := &testLogger{}
+	SetLogger(l)
+	defer SetLogger(nil)
+
+	gobottest.Assert(t, Log(), Logger(l))
+	NewRobot("logbot")
+	gobottest.Assert(t, l.msgs, []string{"info Robot initialized"})
+
+	SetLogger(nil)
+	gobottest.Refute(t, Log(), Logger(l))
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/signal"
 	"sync/atomic"
+	"time"
 )
 
 // JSONMaster is a JSON representation of a Gobot Master.
@@ -32,10 +33,11 @@ func NewJSONMaster(gobot *Master) *JSONMaster {
 // Master is the main type of your Gobot application and contains a collection of
 // Robots, API commands that apply to the Master, and Events that apply to the Master.
 type Master struct {
-	robots  *Robots
-	trap    func(chan os.Signal)
-	AutoRun bool
-	running atomic.Value
+	robots      *Robots
+	trap        func(chan os.Signal)
+	AutoRun     bool
+	HaltTimeout time.Duration
+	running     atomic.Value
 	Commander
 	Eventer
 }
@@ -79,9 +81,12 @@ func (g *Master) Start() error {
 	return g.Stop()
 }
 
-// Stop calls the Stop method on each robot in its collection of robots.
+// Stop calls the Stop method on each robot in its collection of robots. If
+// HaltTimeout is set, Stop returns an error after the timeout even if some
+// robots are still stopping. Each robot may limit the halt of its single
+// devices and connections with Robot.HaltStepTimeout.
 func (g *Master) Stop() error {
-	err := g.robots.Stop()
+	err := callWithin(g.HaltTimeout, "stop of robots", g.robots.Stop)
 	g.running.Store(false)
 	return err
 }
@@ -160,3 +160,43 @@ func TestMasterFinalizeErrors(t *testing.T) {
 
 	gobottest.Assert(t, g.Start(), want)
 }
+
+type finalizeSignalingAdaptor struct {
+	testAdaptor
+	finalized chan bool
+}
+
+func (a *finalizeSignalingAdaptor) Finalize() error {
+	a.finalized <- true
+	return nil
+}
+
+func TestMasterHaltTimeout(t *testing.T) {
+	log.SetOutput(&NullReadWriteCloser{})
+	var started, halted []string
+	adaptor := &finalizeSignalingAdaptor{testAdaptor: *newTestAdaptor("Connection1", "/dev/null"), finalized: make(chan bool, 2)}
+	motor := &haltRecordingDriver{halted: &halted}
+	motor.startRecordingDriver = startRecordingDriver{testDriver: *newTestDriver(&adaptor.testAdaptor, "motor", "1"), started: &started}
+	r := NewRobot("Robot99", []Connection{adaptor}, []Device{motor})
+	r.trap = func(c chan os.Signal) {
+		c <- os.Interrupt
+	}
+	g := NewMaster()
+	g.AutoRun = false
+	g.HaltTimeout = 20 * time.Millisecond
+	g.AddRobot(r)
+
+	gobottest.Assert(t, g.Start(), nil)
+	// the auto running robot was stopped already by the trap
+	<-adaptor.finalized
+
+	motor.block = make(chan bool)
+	err := g.Stop()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, err.Error(), "stop of robots timed out")
+	gobottest.Assert(t, g.Running(), false)
+
+	// let the robot finish its stop, so no goroutine outlives the test
+	close(motor.block)
+	<-adaptor.finalized
+}
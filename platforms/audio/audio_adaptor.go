@@ -3,7 +3,6 @@ package audio
 
 import (
 	"errors"
-	"log"
 	"os"
 	"os/exec"
 	"path"
@@ -40,14 +39,14 @@ func (a *Adaptor) Sound(fileName string) []error {
 	var errorsList []error
 
 	if fileName == "" {
-		log.Println("Requires filename for audio file.")
+		gobot.Log().Error("Requires filename for audio file.")
 		errorsList = append(errorsList, errors.New("Requires filename for audio file."))
 		return errorsList
 	}
 
 	_, err := os.Stat(fileName)
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Audio file not found", "file", fileName, "error", err)
 		errorsList = append(errorsList, err)
 		return errorsList
 	}
@@ -55,14 +54,14 @@ func (a *Adaptor) Sound(fileName string) []error {
 	// command to play audio file based on file type
 	commandName, err := CommandName(fileName)
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("No command to play audio file", "file", fileName, "error", err)
 		errorsList = append(errorsList, err)
 		return errorsList
 	}
 
 	err = RunCommand(commandName, fileName)
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Playing audio file failed", "file", fileName, "error", err)
 		errorsList = append(errorsList, err)
 		return errorsList
 	}
@@ -2,7 +2,6 @@ package ble
 
 import (
 	"bytes"
-	"log"
 
 	"gobot.io/x/gobot/v2"
 )
@@ -52,7 +51,7 @@ func (b *BatteryDriver) GetBatteryLevel() (level uint8) {
 	var l uint8
 	c, err := b.adaptor().ReadCharacteristic("2a19")
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Reading battery level failed", "error", err)
 		return
 	}
 	buf := bytes.NewBuffer(c)
@@ -2,7 +2,6 @@ package ble
 
 import (
 	"fmt"
-	"log"
 	"sync"
 	"time"
 
@@ -115,7 +114,7 @@ func (b *ClientAdaptor) Connect() error {
 	for _, srvc := range srvcs {
 		chars, err := srvc.DiscoverCharacteristics(nil)
 		if err != nil {
-			log.Println(err)
+			gobot.Log().Error("Discovering BLE characteristics failed", "service", srvc.UUID().String(), "error", err)
 			continue
 		}
 		for _, char := range chars {
@@ -2,7 +2,6 @@ package ble
 
 import (
 	"bytes"
-	"log"
 
 	"gobot.io/x/gobot/v2"
 )
@@ -51,7 +50,7 @@ func (b *DeviceInformationDriver) Halt() (err error) { return }
 func (b *DeviceInformationDriver) GetModelNumber() (model string) {
 	c, err := b.adaptor().ReadCharacteristic("2a24")
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Reading model number failed", "error", err)
 		return
 	}
 	buf := bytes.NewBuffer(c)
@@ -63,7 +62,7 @@ func (b *DeviceInformationDriver) GetModelNumber() (model string) {
 func (b *DeviceInformationDriver) GetFirmwareRevision() (revision string) {
 	c, err := b.adaptor().ReadCharacteristic("2a26")
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Reading firmware revision failed", "error", err)
 		return
 	}
 	buf := bytes.NewBuffer(c)
@@ -75,7 +74,7 @@ func (b *DeviceInformationDriver) GetFirmwareRevision() (revision string) {
 func (b *DeviceInformationDriver) GetHardwareRevision() (revision string) {
 	c, err := b.adaptor().ReadCharacteristic("2a27")
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Reading hardware revision failed", "error", err)
 		return
 	}
 	buf := bytes.NewBuffer(c)
@@ -87,7 +86,7 @@ func (b *DeviceInformationDriver) GetHardwareRevision() (revision string) {
 func (b *DeviceInformationDriver) GetManufacturerName() (manufacturer string) {
 	c, err := b.adaptor().ReadCharacteristic("2a29")
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Reading manufacturer name failed", "error", err)
 		return
 	}
 	buf := bytes.NewBuffer(c)
@@ -99,7 +98,7 @@ func (b *DeviceInformationDriver) GetManufacturerName() (manufacturer string) {
 func (b *DeviceInformationDriver) GetPnPId() (model string) {
 	c, err := b.adaptor().ReadCharacteristic("2a50")
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Reading PnP ID failed", "error", err)
 		return
 	}
 	buf := bytes.NewBuffer(c)
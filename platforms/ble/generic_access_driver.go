@@ -3,7 +3,6 @@ package ble
 import (
 	"bytes"
 	"encoding/binary"
-	"log"
 
 	"gobot.io/x/gobot/v2"
 )
@@ -50,7 +49,7 @@ func (b *GenericAccessDriver) Halt() error { return nil }
 func (b *GenericAccessDriver) GetDeviceName() string {
 	c, err := b.adaptor().ReadCharacteristic("2a00")
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Reading device name failed", "error", err)
 		return ""
 	}
 
@@ -63,7 +62,7 @@ func (b *GenericAccessDriver) GetDeviceName() string {
 func (b *GenericAccessDriver) GetAppearance() string {
 	c, err := b.adaptor().ReadCharacteristic("2a01")
 	if err != nil {
-		log.Println(err)
+		gobot.Log().Error("Reading appearance failed", "error", err)
 		return ""
 	}
 
@@ -1,7 +1,6 @@
 package keyboard
 
 import (
-	"log"
 	"os"
 
 	"gobot.io/x/gobot/v2"
@@ -45,7 +44,8 @@ func NewDriver() *Driver {
 				if keybuf == ctrlc {
 					proc, err := os.FindProcess(os.Getpid())
 					if err != nil {
-						log.Fatal(err)
+						gobot.Log().Error("Finding own process failed", "error", err)
+						os.Exit(1)
 					}
 
 					if err := proc.Signal(os.Interrupt); err != nil {
@@ -3,7 +3,6 @@ package leap
 import (
 	"encoding/json"
 	"io"
-	"log"
 
 	"gobot.io/x/gobot/v2"
 	"golang.org/x/net/websocket"
@@ -102,7 +101,7 @@ func (l *Driver) Start() error {
 			l.receive(l.adaptor().ws, &msg)
 			frame, err = l.ParseFrame(msg)
 			if err != nil {
-				log.Println(err)
+				gobot.Log().Error("Parsing Leap Motion frame failed", "error", err)
 				continue
 			}
 
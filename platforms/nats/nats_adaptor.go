@@ -1,7 +1,6 @@
 package nats
 
 import (
-	"log"
 	"net/url"
 	"strings"
 
@@ -113,7 +112,7 @@ func (a *Adaptor) Publish(topic string, message []byte) bool {
 	}
 
 	if err := a.client.Publish(topic, message); err != nil {
-		log.Println(err)
+		gobot.Log().Error("Publishing to NATS failed", "topic", topic, "error", err)
 		return false
 	}
 
@@ -129,7 +128,7 @@ func (a *Adaptor) On(event string, f func(msg Message)) bool {
 	if _, err := a.client.Subscribe(event, func(msg *nats.Msg) {
 		f(msg)
 	}); err != nil {
-		log.Println(err)
+		gobot.Log().Error("Subscribing to NATS failed", "subject", event, "error", err)
 		return false
 	}
 
@@ -1,11 +1,13 @@
 package gobot
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
 	"os"
 	"os/signal"
 	"sync/atomic"
+	"time"
 
 	"sync"
 
@@ -41,6 +43,29 @@ func NewJSONRobot(robot *Robot) *JSONRobot {
 	return jsonRobot
 }
 
+// defaultMaxBackoff limits the delay between restarts of the work routine if
+// RestartPolicy.MaxBackoff is not set
+const defaultMaxBackoff = time.Minute
+
+// RestartPolicy describes how a Robot recovers from a panic in its work routine.
+// If a RestartPolicy is set, panics in functions scheduled by the Every and After
+// methods of the Robot are recovered and logged as well, but none of the fields
+// below applies to them: a panicking Every tick is skipped and not counted by
+// RobotWork.TickCount.
+type RestartPolicy struct {
+	// HaltDevices halts all devices after a panic of the work routine. The
+	// devices are started again before the work routine is restarted.
+	HaltDevices bool
+	// MaxRestarts is the number of times the work routine is restarted after
+	// a panic. Zero means the work routine is not restarted at all.
+	MaxRestarts int
+	// Backoff is the delay before the first restart, it is doubled for each
+	// further restart.
+	Backoff time.Duration
+	// MaxBackoff limits the delay between restarts. Zero means one minute.
+	MaxBackoff time.Duration
+}
+
 // Robot is a named entity that manages a collection of connections and devices.
 // It contains its own work routine and a collection of
 // custom commands to control a robot remotely via the Gobot api.
@@ -51,11 +76,23 @@ type Robot struct {
 	devices            *Devices
 	trap               func(chan os.Signal)
 	AutoRun            bool
+	RestartPolicy      *RestartPolicy
+	HaltStepTimeout    time.Duration
 	running            atomic.Value
+	startedAt          atomic.Value
 	done               chan bool
+	workCtx            context.Context
+	stopWork           context.CancelFunc
+	workExited         chan struct{}
+	stopWorkMutex      sync.Mutex
 	workRegistry       *RobotWorkRegistry
 	WorkEveryWaitGroup *sync.WaitGroup
 	WorkAfterWaitGroup *sync.WaitGroup
+	startPolicies      map[Device]DeviceStartPolicy
+	retryPolicies      map[Connection]ConnectionRetryPolicy
+	beforeStartHooks   []func() error
+	afterStartHooks    []func() error
+	beforeHaltHooks    []func() error
 	Commander
 	Eventer
 }
@@ -127,16 +164,16 @@ func NewRobot(v ...interface{}) *Robot {
 		case string:
 			r.Name = v[i].(string)
 		case []Connection:
-			log.Println("Initializing connections...")
+			Log().Info("Initializing connections...")
 			for _, connection := range v[i].([]Connection) {
 				c := r.AddConnection(connection)
-				log.Println("Initializing connection", c.Name(), "...")
+				Log().Info("Initializing connection...", "name", c.Name())
 			}
 		case []Device:
-			log.Println("Initializing devices...")
+			Log().Info("Initializing devices...")
 			for _, device := range v[i].([]Device) {
 				d := r.AddDevice(device)
-				log.Println("Initializing device", d.Name(), "...")
+				Log().Info("Initializing device...", "name", d.Name())
 			}
 		case func():
 			r.Work = v[i].(func())
@@ -150,7 +187,8 @@ func NewRobot(v ...interface{}) *Robot {
 	r.WorkEveryWaitGroup = &sync.WaitGroup{}
 
 	r.running.Store(false)
-	log.Println("Robot", r.Name, "initialized.")
+	r.startedAt.Store(time.Time{})
+	Log().Info("Robot initialized", "name", r.Name)
 
 	return r
 }
@@ -161,14 +199,29 @@ func (r *Robot) Start(args ...interface{}) error {
 	if len(args) > 0 && args[0] != nil {
 		r.AutoRun = args[0].(bool)
 	}
-	log.Println("Starting Robot", r.Name, "...")
-	if err := r.Connections().Start(); err != nil {
-		log.Println(err)
+	Log().Info("Starting Robot...", "name", r.Name)
+	if err := runHooks(r.beforeStartHooks); err != nil {
+		Log().Error("Starting Robot failed", "name", r.Name, "error", err)
+		return err
+	}
+
+	if err := r.startConnections(); err != nil {
+		Log().Error("Starting Robot failed", "name", r.Name, "error", err)
+		return err
+	}
+
+	if err := r.startDevices(); err != nil {
+		Log().Error("Starting Robot failed", "name", r.Name, "error", err)
 		return err
 	}
 
-	if err := r.Devices().Start(); err != nil {
-		log.Println(err)
+	if err := runHooks(r.afterStartHooks); err != nil {
+		Log().Error("Starting Robot failed", "name", r.Name, "error", err)
+		// connections and devices are started already, so leave them in a
+		// sane, stopped state
+		if e := r.shutdown(); e != nil {
+			err = multierror.Append(err, e)
+		}
 		return err
 	}
 
@@ -176,14 +229,20 @@ func (r *Robot) Start(args ...interface{}) error {
 		r.Work = func() {}
 	}
 
-	log.Println("Starting work...")
+	Log().Info("Starting work...", "robot", r.Name)
+	r.startedAt.Store(time.Now())
+	r.running.Store(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	r.stopWorkMutex.Lock()
+	exited := make(chan struct{})
+	r.workCtx, r.stopWork, r.workExited = ctx, cancel, exited
+	r.stopWorkMutex.Unlock()
 	go func() {
-		r.Work()
+		r.runWork(ctx)
+		close(exited)
 		<-r.done
 	}()
 
-	r.running.Store(true)
-
 	if !r.AutoRun {
 		return nil
 	}
@@ -199,14 +258,38 @@ func (r *Robot) Start(args ...interface{}) error {
 }
 
 // Stop stops a Robot's connections and devices. We try to stop all items and
-// collect all errors.
+// collect all errors. Devices are halted in reverse start order, so a device
+// is halted before the devices it depends on. Afterwards the connections are
+// finalized. If HaltStepTimeout is set, Stop waits for each step, that is the
+// halt hooks, the halt of a single device or the finalize of a single
+// connection, at most that long and continues with the next one, so a hanging
+// device can not prevent e.g. motors from being stopped. Thus the whole Stop
+// may take a multiple of HaltStepTimeout, use Master.HaltTimeout for an overall
+// limit. The connection of a device which is still halting after the timeout
+// is not finalized, because the device may still use it.
+//
+// Before anything is halted, Stop waits for the work routine to return, which
+// includes any restart in progress due to a RestartPolicy. Therefore Stop must
+// not be called from within the work routine itself.
 func (r *Robot) Stop() error {
+	Log().Info("Stopping Robot...", "name", r.Name)
 	var err error
-	log.Println("Stopping Robot", r.Name, "...")
-	if e := r.Devices().Halt(); e != nil {
-		err = multierror.Append(err, e)
+	r.stopWorkMutex.Lock()
+	if r.stopWork != nil {
+		r.stopWork()
 	}
-	if e := r.Connections().Finalize(); e != nil {
+	exited := r.workExited
+	r.stopWorkMutex.Unlock()
+	if exited != nil {
+		wait := func() error {
+			<-exited
+			return nil
+		}
+		if e := callWithin(r.HaltStepTimeout, "return of work routine", wait); e != nil {
+			err = multierror.Append(err, e)
+		}
+	}
+	if e := r.shutdown(); e != nil {
 		err = multierror.Append(err, e)
 	}
 
@@ -215,11 +298,206 @@ func (r *Robot) Stop() error {
 	return err
 }
 
+// shutdown calls the before halt hooks, halts all devices and finalizes all
+// connections. All errors are collected.
+func (r *Robot) shutdown() (err error) {
+	hooks := func() error { return runHooks(r.beforeHaltHooks) }
+	if e := callWithin(r.HaltStepTimeout, "before halt hooks", hooks); e != nil {
+		err = multierror.Append(err, e)
+	}
+	halting, e := r.haltDevices()
+	if e != nil {
+		err = multierror.Append(err, e)
+	}
+	for _, connection := range *r.connections {
+		if device := usedBy(connection, halting); device != nil {
+			err = multierror.Append(err, fmt.Errorf("finalize of connection %s skipped, device %s is still halting",
+				connection.Name(), device.Name()))
+			continue
+		}
+		if e := callWithin(r.HaltStepTimeout, "finalize of connection "+connection.Name(), connection.Finalize); e != nil {
+			err = multierror.Append(err, e)
+		}
+	}
+	return err
+}
+
+// haltDevices halts all devices in reverse start order and returns the devices
+// which are still halting after HaltStepTimeout
+func (r *Robot) haltDevices() (halting []Device, err error) {
+	ordered, oerr := r.orderedDevices()
+	if oerr != nil {
+		// a cycle prevented the start already, so any order will do
+		ordered = *r.devices
+	}
+	for i := len(ordered) - 1; i >= 0; i-- {
+		device := ordered[i]
+		if e := callWithin(r.HaltStepTimeout, "halt of device "+device.Name(), device.Halt); e != nil {
+			if errors.Is(e, errTimedOut) {
+				halting = append(halting, device)
+			}
+			err = multierror.Append(err, e)
+		}
+	}
+	return halting, err
+}
+
+// usedBy returns the first of the devices using the connection, or nil
+func usedBy(connection Connection, devices []Device) Device {
+	for _, device := range devices {
+		if device.Connection() == connection {
+			return device
+		}
+	}
+	return nil
+}
+
+// errTimedOut is wrapped by the error of callWithin after the timeout
+var errTimedOut = errors.New("timed out")
+
+// callWithin calls f and waits at most the timeout for its result. A timeout
+// of zero waits without limit. After the timeout f keeps running in the
+// background.
+func callWithin(timeout time.Duration, name string, f func() error) error {
+	if timeout <= 0 {
+		return f()
+	}
+
+	result := make(chan error, 1)
+	go func() { result <- f() }()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-result:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("%s %w", name, errTimedOut)
+	}
+}
+
+// runWork runs the work routine. If a RestartPolicy is set, panics are recovered
+// and the work routine is restarted according to the policy until ctx is done.
+func (r *Robot) runWork(ctx context.Context) {
+	backoff := time.Duration(0)
+	for restarts := 0; ; restarts++ {
+		if ctx.Err() != nil {
+			return
+		}
+		if !r.recoverPanic("work", r.Work) {
+			return
+		}
+		if ctx.Err() != nil {
+			// Stop halts the devices
+			return
+		}
+		policy := r.RestartPolicy
+		if policy.HaltDevices {
+			if _, err := r.haltDevices(); err != nil {
+				Log().Error("Halting devices after panic failed", "robot", r.Name, "error", err)
+			}
+		}
+		if restarts >= policy.MaxRestarts {
+			Log().Warn("Work of Robot will not be restarted", "name", r.Name)
+			return
+		}
+
+		maxBackoff := policy.MaxBackoff
+		if maxBackoff <= 0 {
+			maxBackoff = defaultMaxBackoff
+		}
+		if backoff == 0 {
+			backoff = policy.Backoff
+		} else {
+			backoff *= 2
+		}
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		// both channels may have been ready
+		if ctx.Err() != nil {
+			return
+		}
+
+		if policy.HaltDevices {
+			if err := r.startDevices(); err != nil {
+				Log().Error("Restarting devices failed", "robot", r.Name, "error", err)
+				return
+			}
+		}
+		Log().Info("Restarting work of Robot...", "name", r.Name)
+	}
+}
+
+// recoverPanic calls f and returns true if f has panicked. Panics are only
+// recovered when a RestartPolicy is set.
+func (r *Robot) recoverPanic(kind string, f func()) (panicked bool) {
+	if r.RestartPolicy != nil {
+		defer func() {
+			if rec := recover(); rec != nil {
+				Log().Error("Recovered panic", "robot", r.Name, "kind", kind, "panic", rec)
+				panicked = true
+			}
+		}()
+	}
+	f()
+	return false
+}
+
+// BeforeStart registers f to be called by Start before any connection is
+// started. An error returned by f aborts the start of the Robot.
+func (r *Robot) BeforeStart(f func() error) {
+	r.beforeStartHooks = append(r.beforeStartHooks, f)
+}
+
+// AfterStart registers f to be called by Start after all connections and
+// devices are started, but before the work routine is run. An error returned
+// by f aborts the start of the Robot. In this case the BeforeHalt hooks are
+// called, the devices are halted and the connections are finalized.
+func (r *Robot) AfterStart(f func() error) {
+	r.afterStartHooks = append(r.afterStartHooks, f)
+}
+
+// BeforeHalt registers f to be called by Stop before any device is halted.
+// Errors returned by f are collected, but do not prevent the devices from
+// being halted.
+func (r *Robot) BeforeHalt(f func() error) {
+	r.beforeHaltHooks = append(r.beforeHaltHooks, f)
+}
+
 // Running returns if the Robot is currently started or not
 func (r *Robot) Running() bool {
 	return r.running.Load().(bool)
 }
 
+// WorkContext returns the context of the running work routine. It is
+// cancelled when the Robot is stopped, so it can be passed e.g. to EveryCtx
+// to end polling loops together with the Robot. Before the Robot is started,
+// an already cancelled context is returned.
+func (r *Robot) WorkContext() context.Context {
+	r.stopWorkMutex.Lock()
+	defer r.stopWorkMutex.Unlock()
+	if r.workCtx == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		return ctx
+	}
+	return r.workCtx
+}
+
+// StartedAt returns the time of the last successful start of the Robot
+func (r *Robot) StartedAt() time.Time {
+	return r.startedAt.Load().(time.Time)
+}
+
 // Devices returns all devices associated with this Robot.
 func (r *Robot) Devices() *Devices {
 	return r.devices
@@ -270,3 +548,13 @@ func (r *Robot) Connection(name string) Connection {
 	}
 	return nil
 }
+
+// runHooks calls all hooks in order of registration and collects the errors
+func runHooks(hooks []func() error) (err error) {
+	for _, hook := range hooks {
+		if e := hook(); e != nil {
+			err = multierror.Append(err, e)
+		}
+	}
+	return err
+}
@@ -1,6 +1,10 @@
 package gobot
 
 import (
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -65,3 +69,277 @@ func TestRobotStartAutoRun(t *testing.T) {
 	gobottest.Assert(t, r.Stop(), nil)
 	gobottest.Assert(t, r.Running(), false)
 }
+
+func TestRobotLifecycleHooks(t *testing.T) {
+	r := newTestRobot("Robot99")
+	var calls []string
+	r.BeforeStart(func() error {
+		calls = append(calls, "beforeStart")
+		return nil
+	})
+	r.AfterStart(func() error {
+		calls = append(calls, "afterStart")
+		return nil
+	})
+	r.BeforeHalt(func() error {
+		calls = append(calls, "beforeHalt")
+		return nil
+	})
+	testDriverHalt = func() (err error) {
+		calls = append(calls, "halt")
+		return
+	}
+	defer func() { testDriverHalt = func() (err error) { return } }()
+
+	gobottest.Assert(t, r.Start(), nil)
+	gobottest.Assert(t, calls, []string{"beforeStart", "afterStart", "beforeHalt", "halt", "halt", "halt"})
+}
+
+func TestRobotBeforeStartHookError(t *testing.T) {
+	r := newTestRobot("Robot99")
+	r.BeforeStart(func() error { return errors.New("hook error") })
+	connected := false
+	testAdaptorConnect = func() (err error) {
+		connected = true
+		return
+	}
+	defer func() { testAdaptorConnect = func() (err error) { return } }()
+
+	err := r.Start()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "hook error"), true)
+	gobottest.Assert(t, connected, false)
+	gobottest.Assert(t, r.Running(), false)
+}
+
+func TestRobotAfterStartHookError(t *testing.T) {
+	r := newTestRobot("Robot99")
+	var calls []string
+	r.AfterStart(func() error { return errors.New("hook error") })
+	r.BeforeHalt(func() error {
+		calls = append(calls, "beforeHalt")
+		return nil
+	})
+	testDriverHalt = func() (err error) {
+		calls = append(calls, "halt")
+		return
+	}
+	testAdaptorFinalize = func() (err error) {
+		calls = append(calls, "finalize")
+		return
+	}
+	defer func() {
+		testDriverHalt = func() (err error) { return }
+		testAdaptorFinalize = func() (err error) { return }
+	}()
+	workCalled := false
+	r.Work = func() { workCalled = true }
+
+	err := r.Start()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "hook error"), true)
+	gobottest.Assert(t, calls, []string{"beforeHalt", "halt", "halt", "halt", "finalize", "finalize", "finalize"})
+	gobottest.Assert(t, r.Running(), false)
+	gobottest.Assert(t, workCalled, false)
+}
+
+func TestRobotBeforeHaltHookError(t *testing.T) {
+	r := newTestRobot("Robot99")
+	r.AutoRun = false
+	r.BeforeHalt(func() error { return errors.New("hook error") })
+
+	gobottest.Assert(t, r.Start(), nil)
+	err := r.Stop()
+	gobottest.Refute(t, err, nil)
+	gobottest.Assert(t, strings.Contains(err.Error(), "hook error"), true)
+	gobottest.Assert(t, r.Running(), false)
+}
+
+func TestRobotWorkPanicWithoutRestartPolicy(t *testing.T) {
+	r := newTestRobot("Robot99")
+	gobottest.Assert(t, r.recoverPanic("work", func() {}), false)
+	defer func() {
+		gobottest.Assert(t, recover(), "work error")
+	}()
+	r.recoverPanic("work", func() { panic("work error") })
+	t.Error("panic should not have been recovered")
+}
+
+func TestRobotWorkRestartPolicy(t *testing.T) {
+	r := newTestRobot("Robot99")
+	r.AutoRun = false
+	r.RestartPolicy = &RestartPolicy{MaxRestarts: 2, Backoff: time.Millisecond}
+	calls := make(chan bool, 10)
+	r.Work = func() {
+		calls <- true
+		panic("work error")
+	}
+
+	gobottest.Assert(t, r.Start(), nil)
+	time.Sleep(50 * time.Millisecond)
+	gobottest.Assert(t, len(calls), 3)
+	gobottest.Assert(t, r.Running(), true)
+	gobottest.Assert(t, r.Stop(), nil)
+}
+
+func TestRobotWorkRestartPolicyStopDuringBackoff(t *testing.T) {
+	r := newTestRobot("Robot99")
+	r.AutoRun = false
+	r.RestartPolicy = &RestartPolicy{MaxRestarts: 1, Backoff: time.Hour}
+	calls := make(chan bool, 10)
+	r.Work = func() {
+		calls <- true
+		panic("work error")
+	}
+
+	gobottest.Assert(t, r.Start(), nil)
+	<-calls
+	stopped := make(chan error)
+	go func() { stopped <- r.Stop() }()
+	select {
+	case err := <-stopped:
+		gobottest.Assert(t, err, nil)
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("Stop was blocked by restart backoff")
+	}
+	gobottest.Assert(t, len(calls), 0)
+}
+
+type restartGateDriver struct {
+	testDriver
+	mutex      sync.Mutex
+	events     []string
+	restarting chan bool
+	release    chan bool
+}
+
+func (d *restartGateDriver) Start() error {
+	d.mutex.Lock()
+	restart := len(d.events) > 0
+	d.events = append(d.events, "start")
+	d.mutex.Unlock()
+	if restart {
+		d.restarting <- true
+		<-d.release
+	}
+	return nil
+}
+
+func (d *restartGateDriver) Halt() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.events = append(d.events, "halt")
+	return nil
+}
+
+func TestRobotWorkRestartPolicyStopDuringRestart(t *testing.T) {
+	adaptor := newTestAdaptor("Connection1", "/dev/null")
+	driver := &restartGateDriver{
+		testDriver: *newTestDriver(adaptor, "Device1", "0"),
+		restarting: make(chan bool, 1),
+		release:    make(chan bool),
+	}
+	r := NewRobot("Robot99", []Connection{adaptor}, []Device{driver})
+	r.AutoRun = false
+	r.RestartPolicy = &RestartPolicy{HaltDevices: true, MaxRestarts: 5}
+	var runs int32
+	r.Work = func() {
+		if atomic.AddInt32(&runs, 1) == 1 {
+			panic("work error")
+		}
+	}
+
+	gobottest.Assert(t, r.Start(), nil)
+	<-driver.restarting
+	stopped := make(chan error)
+	go func() { stopped <- r.Stop() }()
+	select {
+	case <-stopped:
+		t.Fatal("Stop did not wait for the restart of the devices")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(driver.release)
+	gobottest.Assert(t, <-stopped, nil)
+
+	// neither work nor devices are started again after Stop
+	time.Sleep(20 * time.Millisecond)
+	gobottest.Assert(t, atomic.LoadInt32(&runs), int32(1))
+	driver.mutex.Lock()
+	defer driver.mutex.Unlock()
+	gobottest.Assert(t, driver.events, []string{"start", "halt", "start", "halt"})
+}
+
+func TestRobotWorkRestartPolicyHaltDevices(t *testing.T) {
+	r := newTestRobot("Robot99")
+	r.AutoRun = false
+	r.RestartPolicy = &RestartPolicy{HaltDevices: true, MaxRestarts: 1}
+	halts := make(chan bool, 10)
+	testDriverHalt = func() (err error) {
+		halts <- true
+		return
+	}
+	defer func() { testDriverHalt = func() (err error) { return } }()
+	restarted := make(chan bool)
+	first := true
+	r.Work = func() {
+		if first {
+			first = false
+			panic("work error")
+		}
+		restarted <- true
+	}
+
+	gobottest.Assert(t, r.Start(), nil)
+	select {
+	case <-restarted:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("work was not restarted")
+	}
+	gobottest.Assert(t, len(halts), r.Devices().Len())
+	gobottest.Assert(t, r.Stop(), nil)
+}
+
+func TestRobotConnectionHealth(t *testing.T) {
+	r := newTestRobot("Robot99")
+	r.AutoRun = false
+	conn := r.Connection("Connection1")
+
+	health := NewJSONConnectionHealth(r, conn)
+	gobottest.Assert(t, health.Name, "Connection1")
+	gobottest.Assert(t, health.Adaptor, "*gobot.testAdaptor")
+	gobottest.Assert(t, health.State, ConnectionDisconnected)
+	gobottest.Assert(t, health.Uptime, 0.0)
+
+	gobottest.Assert(t, r.Start(), nil)
+	time.Sleep(time.Millisecond)
+	health = NewJSONConnectionHealth(r, conn)
+	gobottest.Assert(t, health.State, ConnectionConnected)
+	gobottest.Assert(t, health.Uptime > 0, true)
+	gobottest.Assert(t, r.Stop(), nil)
+}
+
+func TestRobotWorkContext(t *testing.T) {
+	r := newTestRobot("Robot99")
+	r.AutoRun = false
+	gobottest.Refute(t, r.WorkContext().Err(), nil)
+
+	ticks := make(chan bool, 100)
+	r.Work = func() {
+		EveryCtx(r.WorkContext(), time.Millisecond, func() error {
+			ticks <- true
+			return nil
+		})
+	}
+	gobottest.Assert(t, r.Start(), nil)
+	gobottest.Assert(t, r.WorkContext().Err(), nil)
+	<-ticks
+
+	gobottest.Assert(t, r.Stop(), nil)
+	gobottest.Refute(t, r.WorkContext().Err(), nil)
+	time.Sleep(5 * time.Millisecond)
+	for len(ticks) > 0 {
+		<-ticks
+	}
+	time.Sleep(5 * time.Millisecond)
+	gobottest.Assert(t, len(ticks), 0)
+}
@@ -115,7 +115,9 @@ func (r *Robot) Every(ctx context.Context, d time.Duration, f func()) *RobotWork
 				rw.ticker.Stop()
 				break EVERYWORK
 			case <-rw.ticker.C:
-				f()
+				if r.recoverPanic("every", f) {
+					continue
+				}
 				rw.tickCount++
 			}
 		}
@@ -137,7 +139,7 @@ func (r *Robot) After(ctx context.Context, d time.Duration, f func()) *RobotWork
 				r.workRegistry.delete(rw.id)
 				break AFTERWORK
 			case <-ch:
-				f()
+				r.recoverPanic("after", f)
 			}
 		}
 		r.WorkAfterWaitGroup.Done()
@@ -2,6 +2,7 @@ package gobot
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 
 	"time"
@@ -101,3 +102,20 @@ func collectStringKeysFromWorkRegistry(rwr *RobotWorkRegistry) []string {
 	}
 	return keys
 }
+
+func TestRobotEveryRecoversPanic(t *testing.T) {
+	robot := NewRobot("testbot")
+	robot.RestartPolicy = &RestartPolicy{}
+	var calls int32
+
+	rw := robot.Every(context.Background(), time.Millisecond, func() {
+		atomic.AddInt32(&calls, 1)
+		panic("every error")
+	})
+	time.Sleep(20 * time.Millisecond)
+	rw.CallCancelFunc()
+	robot.WorkEveryWaitGroup.Wait()
+
+	assert.Greater(t, atomic.LoadInt32(&calls), int32(1))
+	assert.Equal(t, 0, rw.TickCount())
+}
@@ -2,7 +2,6 @@ package system
 
 import (
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"time"
@@ -192,12 +191,12 @@ func digitalPinGpiodReconfigureLine(d *digitalPinGpiod, forceInput bool) error {
 	// configure direction, debounce period (inputs only), edge detection (inputs only) and drive (outputs only)
 	if d.direction == IN || forceInput {
 		if systemGpiodDebug {
-			log.Printf("input (%s): debounce %s, edge %d, handler %t, inverse %t, bias %d",
-				id, d.debouncePeriod, d.edge, d.edgeEventHandler != nil, d.activeLow, d.bias)
+			gobot.Log().Debug("configure input", "id", id, "debounce", d.debouncePeriod, "edge", d.edge,
+				"handler", d.edgeEventHandler != nil, "inverse", d.activeLow, "bias", d.bias)
 		}
 		opts = append(opts, gpiod.AsInput)
 		if !forceInput && d.drive != digitalPinDrivePushPull && systemGpiodDebug {
-			log.Printf("\n++ drive option (%d) is dropped for input++\n", d.drive)
+			gobot.Log().Debug("drive option is dropped for input", "drive", d.drive)
 		}
 		if d.debouncePeriod != 0 {
 			opts = append(opts, gpiod.WithDebounce(d.debouncePeriod))
@@ -218,8 +217,8 @@ func digitalPinGpiodReconfigureLine(d *digitalPinGpiod, forceInput bool) error {
 		}
 	} else {
 		if systemGpiodDebug {
-			log.Printf("ouput (%s): ini-state %d, drive %d, inverse %t, bias %d",
-				id, d.outInitialState, d.drive, d.activeLow, d.bias)
+			gobot.Log().Debug("configure output", "id", id, "initialState", d.outInitialState, "drive", d.drive,
+				"inverse", d.activeLow, "bias", d.bias)
 		}
 		opts = append(opts, gpiod.AsOutput(d.outInitialState))
 		switch d.drive {
@@ -231,10 +230,10 @@ func digitalPinGpiodReconfigureLine(d *digitalPinGpiod, forceInput bool) error {
 			opts = append(opts, gpiod.AsPushPull)
 		}
 		if d.debouncePeriod != 0 && systemGpiodDebug {
-			log.Printf("\n++debounce option (%d) is dropped for output++\n", d.drive)
+			gobot.Log().Debug("debounce option is dropped for output", "debouncePeriod", d.debouncePeriod)
 		}
 		if d.edgeEventHandler != nil || d.edge != digitalPinEventNone && systemGpiodDebug {
-			log.Printf("\n++edge detection is dropped for output++\n")
+			gobot.Log().Debug("edge detection is dropped for output")
 		}
 	}
 
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"os"
 	"strconv"
 	"time"
@@ -172,23 +171,23 @@ func (d *digitalPinSysfs) reconfigure() error {
 	// configure bias (unsupported)
 	if err == nil {
 		if d.bias != digitalPinBiasDefault && systemSysfsDebug {
-			log.Printf("bias options (%d) are not supported by sysfs, please use hardware resistors instead\n", d.bias)
+			gobot.Log().Debug("bias options are not supported by sysfs, please use hardware resistors instead", "bias", d.bias)
 		}
 	}
 
 	// configure drive (unsupported)
 	if d.drive != digitalPinDrivePushPull && systemSysfsDebug {
-		log.Printf("drive options (%d) are not supported by sysfs\n", d.drive)
+		gobot.Log().Debug("drive options are not supported by sysfs", "drive", d.drive)
 	}
 
 	// configure debounce (unsupported)
 	if d.debouncePeriod != 0 && systemSysfsDebug {
-		log.Printf("debounce period option (%d) is not supported by sysfs\n", d.debouncePeriod)
+		gobot.Log().Debug("debounce period option is not supported by sysfs", "debouncePeriod", d.debouncePeriod)
 	}
 
 	// configure edge detection (not implemented)
 	if d.edge != 0 && systemSysfsDebug {
-		log.Printf("edge detect option (%d) is not implemented for sysfs\n", d.edge)
+		gobot.Log().Debug("edge detect option is not implemented for sysfs", "edge", d.edge)
 	}
 
 	if err != nil {
@@ -2,10 +2,11 @@ package system
 
 import (
 	"fmt"
-	"log"
 	"os"
 	"sync"
 	"unsafe"
+
+	"gobot.io/x/gobot/v2"
 )
 
 const (
@@ -151,7 +152,7 @@ func (d *i2cDevice) ReadBlockData(address int, reg uint8, data []byte) error {
 	data[0] = 0xFF // set value for debugging purposes
 	if err := d.queryFunctionality(I2C_FUNC_SMBUS_READ_I2C_BLOCK, "read block data"); err != nil {
 		if i2cDeviceDebug {
-			log.Printf("%s, use fallback\n", err.Error())
+			gobot.Log().Debug("use fallback", "error", err)
 		}
 		return d.readBlockDataFallback(address, reg, data)
 	}
@@ -218,7 +219,7 @@ func (d *i2cDevice) WriteBlockData(address int, reg uint8, data []byte) error {
 
 	if err := d.queryFunctionality(I2C_FUNC_SMBUS_WRITE_I2C_BLOCK, "write i2c block"); err != nil {
 		if i2cDeviceDebug {
-			log.Printf("%s, use fallback\n", err.Error())
+			gobot.Log().Debug("use fallback", "error", err)
 		}
 		return d.writeBlockDataFallback(address, reg, data)
 	}
@@ -358,7 +359,7 @@ func (d *i2cDevice) smbusAccess(address int, readWrite byte, command byte, proto
 func (d *i2cDevice) setAddress(address int) error {
 	if d.lastAddress == address && !forceSetAddress {
 		if i2cDeviceDebug {
-			log.Printf("I2C address %d was already sent - skip", address)
+			gobot.Log().Debug("I2C address was already sent - skip", "address", address)
 		}
 		return nil
 	}
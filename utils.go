@@ -1,6 +1,7 @@
 package gobot
 
 import (
+	"context"
 	"crypto/rand"
 	"fmt"
 	"math"
@@ -30,6 +31,65 @@ func After(t time.Duration, f func()) {
 	time.AfterFunc(t, f)
 }
 
+// EveryCtx triggers f every t time.Duration until ctx is cancelled. In contrast
+// to Every, the next f is not fired before the previous execution has finished.
+// Use Robot.WorkContext as ctx to stop the loop together with the Robot.
+// Errors returned by f are sent to the returned channel, which is closed after
+// ctx is done. The channel holds only the most recent error, so an unread
+// error is overwritten by the next one and never stalls the loop.
+func EveryCtx(ctx context.Context, t time.Duration, f func() error) <-chan error {
+	errs := make(chan error, 1)
+	ticker := time.NewTicker(t)
+
+	go func() {
+		defer close(errs)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := f(); err != nil {
+					select {
+					case errs <- err:
+					default:
+						// replace the unread error, this goroutine is the only sender
+						select {
+						case <-errs:
+						default:
+						}
+						errs <- err
+					}
+				}
+			}
+		}
+	}()
+
+	return errs
+}
+
+// AfterCtx triggers f after t duration, unless ctx is cancelled before. The
+// error returned by f is sent to the returned channel, which is closed
+// afterwards or when ctx is cancelled before f was triggered.
+func AfterCtx(ctx context.Context, t time.Duration, f func() error) <-chan error {
+	errs := make(chan error, 1)
+	timer := time.NewTimer(t)
+
+	go func() {
+		defer close(errs)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+			if err := f(); err != nil {
+				errs <- err
+			}
+		}
+	}()
+
+	return errs
+}
+
 // Rand returns a positive random int up to max
 func Rand(max int) int {
 	i, _ := rand.Int(rand.Reader, big.NewInt(int64(max)))
@@ -1,6 +1,9 @@
 package gobot
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -46,6 +49,95 @@ func TestEveryWhenStopped(t *testing.T) {
 	}
 }
 
+func TestEveryCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	i := 0
+
+	errs := EveryCtx(ctx, 2*time.Millisecond, func() error {
+		i++
+		if i == 2 {
+			return errors.New("every error")
+		}
+		return nil
+	})
+
+	select {
+	case err := <-errs:
+		gobottest.Assert(t, err.Error(), "every error")
+	case <-time.After(100 * time.Millisecond):
+		t.Error("EveryCtx did not report the error")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-errs:
+		gobottest.Assert(t, ok, false)
+	case <-time.After(100 * time.Millisecond):
+		t.Error("EveryCtx should have stopped")
+	}
+}
+
+func TestEveryCtxUnreadErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := make(chan int, 10)
+	i := 0
+
+	errs := EveryCtx(ctx, time.Millisecond, func() error {
+		i++
+		if i <= 5 {
+			calls <- i
+		}
+		return fmt.Errorf("error %d", i)
+	})
+
+	// polling goes on although the errors are not read
+	for j := 1; j <= 5; j++ {
+		select {
+		case n := <-calls:
+			gobottest.Assert(t, n, j)
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("EveryCtx stalled on unread errors")
+		}
+	}
+	err := <-errs
+	gobottest.Refute(t, err, nil)
+}
+
+func TestAfterCtx(t *testing.T) {
+	errs := AfterCtx(context.Background(), 2*time.Millisecond, func() error {
+		return errors.New("after error")
+	})
+
+	select {
+	case err := <-errs:
+		gobottest.Assert(t, err.Error(), "after error")
+	case <-time.After(100 * time.Millisecond):
+		t.Error("AfterCtx did not report the error")
+	}
+	_, ok := <-errs
+	gobottest.Assert(t, ok, false)
+}
+
+func TestAfterCtxWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	called := false
+
+	errs := AfterCtx(ctx, 50*time.Millisecond, func() error {
+		called = true
+		return nil
+	})
+	cancel()
+
+	select {
+	case _, ok := <-errs:
+		gobottest.Assert(t, ok, false)
+	case <-time.After(100 * time.Millisecond):
+		t.Error("AfterCtx should have stopped")
+	}
+	gobottest.Assert(t, called, false)
+}
+
 func TestAfter(t *testing.T) {
 	i := 0
 	sem := make(chan bool)